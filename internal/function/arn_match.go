@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const (
+	// arnSections is the number of colon-delimited sections in an ARN:
+	// arn:partition:service:region:account-id:resource
+	arnSections = 6
+)
+
+var _ function.Function = arnMatchFunction{}
+
+func NewARNMatchFunction() function.Function {
+	return &arnMatchFunction{}
+}
+
+type arnMatchFunction struct{}
+
+func (f arnMatchFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_match"
+}
+
+func (f arnMatchFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_match Function",
+		MarkdownDescription: "Checks whether an ARN matches an IAM-style ARN pattern. Each section of the pattern may contain " +
+			"`*` and `?` wildcards",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "ARN pattern, for example `arn:aws:s3:::example-*/*`, or `*` to match any ARN",
+			},
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to match",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f arnMatchFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern, arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := arnMatch(pattern, arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// arnMatch matches each section of an ARN against the corresponding section of the
+// pattern, as IAM does for the Resource element of a policy. Wildcards do not
+// match across section boundaries, except in the resource section which may itself
+// contain colons.
+func arnMatch(pattern, s string) (bool, error) {
+	if _, err := arn.Parse(s); err != nil {
+		return false, err
+	}
+
+	if pattern == "*" {
+		return true, nil
+	}
+
+	patternSections := strings.SplitN(pattern, ":", arnSections)
+	if len(patternSections) != arnSections || patternSections[0] != "arn" {
+		return false, errors.New("pattern must be \"*\" or of the form arn:partition:service:region:account-id:resource")
+	}
+
+	sections := strings.SplitN(s, ":", arnSections)
+	for i := range arnSections {
+		if !wildcardMatch(patternSections[i], sections[i]) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestARNMatchFunction_match(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNMatchFunctionConfig("arn:aws:iam::444455556666:role/example", "arn:aws:iam::444455556666:role/example"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(true)),
+				},
+			},
+			{
+				Config: testARNMatchFunctionConfig("arn:*:iam::*:role/*", "arn:aws-us-gov:iam::444455556666:role/path/example"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(true)),
+				},
+			},
+			{
+				Config: testARNMatchFunctionConfig("arn:aws:logs:us-????-1:*:log-group:/aws/lambda/*", "arn:aws:logs:us-east-1:444455556666:log-group:/aws/lambda/example:*"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(true)),
+				},
+			},
+			{
+				Config: testARNMatchFunctionConfig("*", "arn:aws:s3:::example"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(true)),
+				},
+			},
+			{
+				Config: testARNMatchFunctionConfig("arn:aws:s3:::*", "arn:aws:s3:::*x"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(true)),
+				},
+			},
+			{
+				Config: testARNMatchFunctionConfig("arn:aws:s3:::a*c", "arn:aws:s3:::a*bc"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(true)),
+				},
+			},
+		},
+	})
+}
+
+func TestARNMatchFunction_noMatch(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNMatchFunctionConfig("arn:aws:iam::444455556666:role/*", "arn:aws:iam::111122223333:role/example"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(false)),
+				},
+			},
+			{
+				Config: testARNMatchFunctionConfig("arn:aws:sqs:us-east-?:*:example", "arn:aws:sqs:us-west-2:444455556666:example"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(false)),
+				},
+			},
+		},
+	})
+}
+
+func TestARNMatchFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNMatchFunctionConfig("*", "invalid"),
+				ExpectError: regexache.MustCompile("arn: invalid prefix"),
+			},
+			{
+				Config:      testARNMatchFunctionConfig("arn:aws:s3:*", "arn:aws:s3:::example"),
+				ExpectError: regexache.MustCompile(`pattern[\s\n]*must[\s\n]*be`),
+			},
+		},
+	})
+}
+
+func testARNMatchFunctionConfig(pattern, arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::arn_match(%[1]q, %[2]q)
+}
+`, pattern, arg)
+}
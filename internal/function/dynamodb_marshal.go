@@ -0,0 +1,299 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	dynamoDBMarshalOptionBinaryPrefix = "binary:"
+	dynamoDBMarshalOptionInferSets    = "infer_sets"
+)
+
+var _ function.Function = dynamoDBMarshalFunction{}
+
+func NewDynamoDBMarshalFunction() function.Function {
+	return &dynamoDBMarshalFunction{}
+}
+
+type dynamoDBMarshalFunction struct{}
+
+func (f dynamoDBMarshalFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dynamodb_marshal"
+}
+
+func (f dynamoDBMarshalFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "dynamodb_marshal Function",
+		MarkdownDescription: "Converts an object into a DynamoDB item in AttributeValue JSON format, " +
+			"as used by the `item` argument of the `aws_dynamodb_table_item` resource",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "item",
+				MarkdownDescription: "Object or map to convert",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name: "options",
+			MarkdownDescription: "Conversion options. Valid values are `infer_sets`, to convert lists of unique strings or numbers " +
+				"to string or number sets, and `binary:<attribute>`, to convert the base64-encoded string value of the named attribute to binary",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f dynamoDBMarshalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var item types.Dynamic
+	var options []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &item, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	m := dynamoDBMarshaler{
+		binaryAttributes: make(map[string]struct{}),
+	}
+	for _, option := range options {
+		switch {
+		case option == dynamoDBMarshalOptionInferSets:
+			m.inferSets = true
+		case strings.HasPrefix(option, dynamoDBMarshalOptionBinaryPrefix) && len(option) > len(dynamoDBMarshalOptionBinaryPrefix):
+			m.binaryAttributes[strings.TrimPrefix(option, dynamoDBMarshalOptionBinaryPrefix)] = struct{}{}
+		default:
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("unsupported option (%s)", option)))
+			return
+		}
+	}
+
+	attributes, err := m.marshalItem(item.UnderlyingValue())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, err := tfdynamodb.FlattenTableItemAttributes(attributes)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, strings.TrimSpace(result)))
+}
+
+// dynamoDBMarshaler converts Terraform values to DynamoDB attribute values.
+type dynamoDBMarshaler struct {
+	// binaryAttributes are the names of top-level attributes whose base64-encoded string values are binary.
+	binaryAttributes map[string]struct{}
+	// inferSets converts lists of unique strings or numbers to string or number sets.
+	inferSets bool
+}
+
+func (m dynamoDBMarshaler) marshalItem(v attr.Value) (map[string]awstypes.AttributeValue, error) {
+	var elements map[string]attr.Value
+
+	switch v := v.(type) {
+	case basetypes.ObjectValue:
+		if v.IsNull() {
+			return nil, errors.New("item must not be null")
+		}
+		elements = v.Attributes()
+	case basetypes.MapValue:
+		if v.IsNull() {
+			return nil, errors.New("item must not be null")
+		}
+		elements = v.Elements()
+	default:
+		return nil, errors.New("item must be an object or map")
+	}
+
+	apiObject := make(map[string]awstypes.AttributeValue, len(elements))
+	for k, v := range elements {
+		var av awstypes.AttributeValue
+		var err error
+
+		if _, ok := m.binaryAttributes[k]; ok {
+			av, err = m.marshalBinary(v)
+		} else {
+			av, err = m.marshal(v)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("attribute (%s): %w", k, err)
+		}
+
+		apiObject[k] = av
+	}
+
+	return apiObject, nil
+}
+
+func (m dynamoDBMarshaler) marshal(v attr.Value) (awstypes.AttributeValue, error) {
+	if v.IsNull() {
+		return &awstypes.AttributeValueMemberNULL{Value: true}, nil
+	}
+
+	switch v := v.(type) {
+	case basetypes.DynamicValue:
+		return m.marshal(v.UnderlyingValue())
+	case basetypes.BoolValue:
+		return &awstypes.AttributeValueMemberBOOL{Value: v.ValueBool()}, nil
+	case basetypes.NumberValue:
+		return &awstypes.AttributeValueMemberN{Value: v.ValueBigFloat().Text('f', -1)}, nil
+	case basetypes.StringValue:
+		return &awstypes.AttributeValueMemberS{Value: v.ValueString()}, nil
+	case basetypes.ListValue:
+		return m.marshalList(v.Elements(), m.inferSets)
+	case basetypes.TupleValue:
+		return m.marshalList(v.Elements(), m.inferSets)
+	case basetypes.SetValue:
+		elements := v.Elements()
+		if len(elements) == 0 {
+			return nil, errors.New("empty sets are not supported")
+		}
+		return m.marshalList(elements, true)
+	case basetypes.MapValue:
+		return m.marshalMap(v.Elements())
+	case basetypes.ObjectValue:
+		return m.marshalMap(v.Attributes())
+	default:
+		return nil, fmt.Errorf("unsupported type (%T)", v)
+	}
+}
+
+// marshalList converts the elements of a list, set or tuple to a list attribute value,
+// or if asSet is true and the elements are unique strings or numbers, to a string or number set.
+func (m dynamoDBMarshaler) marshalList(elements []attr.Value, asSet bool) (awstypes.AttributeValue, error) {
+	if asSet && len(elements) > 0 {
+		if v, ok := dynamoDBScalarSet(elements); ok {
+			return v, nil
+		}
+	}
+
+	l := make([]awstypes.AttributeValue, len(elements))
+	for i, v := range elements {
+		av, err := m.marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		l[i] = av
+	}
+
+	return &awstypes.AttributeValueMemberL{Value: l}, nil
+}
+
+func (m dynamoDBMarshaler) marshalMap(elements map[string]attr.Value) (awstypes.AttributeValue, error) {
+	apiObject := make(map[string]awstypes.AttributeValue, len(elements))
+	for k, v := range elements {
+		av, err := m.marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("attribute (%s): %w", k, err)
+		}
+		apiObject[k] = av
+	}
+
+	return &awstypes.AttributeValueMemberM{Value: apiObject}, nil
+}
+
+// marshalBinary converts a base64-encoded string to a binary attribute value,
+// or a list or set of base64-encoded strings to a binary set.
+func (m dynamoDBMarshaler) marshalBinary(v attr.Value) (awstypes.AttributeValue, error) {
+	if v, ok := v.(basetypes.DynamicValue); ok {
+		return m.marshalBinary(v.UnderlyingValue())
+	}
+
+	if v.IsNull() {
+		return &awstypes.AttributeValueMemberNULL{Value: true}, nil
+	}
+
+	var elements []attr.Value
+	switch v := v.(type) {
+	case basetypes.StringValue:
+		b, err := inttypes.Base64Decode(v.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		return &awstypes.AttributeValueMemberB{Value: b}, nil
+	case basetypes.ListValue:
+		elements = v.Elements()
+	case basetypes.SetValue:
+		elements = v.Elements()
+	case basetypes.TupleValue:
+		elements = v.Elements()
+	default:
+		return nil, errors.New("binary value must be a base64-encoded string or a list of base64-encoded strings")
+	}
+
+	if len(elements) == 0 {
+		return nil, errors.New("empty sets are not supported")
+	}
+
+	bs := make([][]byte, len(elements))
+	for i, v := range elements {
+		s, ok := v.(basetypes.StringValue)
+		if !ok || s.IsNull() {
+			return nil, fmt.Errorf("element %d: binary set elements must be base64-encoded strings", i)
+		}
+		b, err := inttypes.Base64Decode(s.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		bs[i] = b
+	}
+
+	return &awstypes.AttributeValueMemberBS{Value: bs}, nil
+}
+
+// dynamoDBScalarSet returns a string or number set attribute value if all elements are
+// unique, non-null strings or unique, non-null numbers.
+func dynamoDBScalarSet(elements []attr.Value) (awstypes.AttributeValue, bool) {
+	var ss, ns []string
+	seen := make(map[string]struct{}, len(elements))
+
+	for _, v := range elements {
+		if d, ok := v.(basetypes.DynamicValue); ok {
+			v = d.UnderlyingValue()
+		}
+		if v == nil || v.IsNull() {
+			return nil, false
+		}
+
+		var s string
+		switch v := v.(type) {
+		case basetypes.StringValue:
+			s = v.ValueString()
+			ss = append(ss, s)
+		case basetypes.NumberValue:
+			s = v.ValueBigFloat().Text('f', -1)
+			ns = append(ns, s)
+		default:
+			return nil, false
+		}
+
+		if _, ok := seen[s]; ok {
+			return nil, false
+		}
+		seen[s] = struct{}{}
+	}
+
+	switch {
+	case len(ns) == 0:
+		return &awstypes.AttributeValueMemberSS{Value: ss}, true
+	case len(ss) == 0:
+		return &awstypes.AttributeValueMemberNS{Value: ns}, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestDynamoDBMarshalFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testDynamoDBMarshalFunctionConfig(`{ id = "example", count = 3, enabled = true, ratio = 0.5, missing = null }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"count":{"N":"3"},"enabled":{"BOOL":true},"id":{"S":"example"},"missing":{"NULL":true},"ratio":{"N":"0.5"}}`),
+				),
+			},
+			{
+				Config: testDynamoDBMarshalFunctionConfig(`{ id = "example", tags = toset(["a", "b"]), scores = [1, 2], nested = { values = ["x", 1] } }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"id":{"S":"example"},"nested":{"M":{"values":{"L":[{"S":"x"},{"N":"1"}]}}},"scores":{"L":[{"N":"1"},{"N":"2"}]},"tags":{"SS":["a","b"]}}`),
+				),
+			},
+			{
+				Config: testDynamoDBMarshalFunctionConfig(`{ id = "example", scores = [1, 2], data = "aGVsbG8=" }`, "infer_sets", "binary:data"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"data":{"B":"aGVsbG8="},"id":{"S":"example"},"scores":{"NS":["1","2"]}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestDynamoDBMarshalFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testDynamoDBMarshalFunctionConfig(`"example"`),
+				ExpectError: regexache.MustCompile(`item[\s\n]*must[\s\n]*be[\s\n]*an[\s\n]*object[\s\n]*or[\s\n]*map`),
+			},
+			{
+				Config:      testDynamoDBMarshalFunctionConfig(`{ id = "example" }`, "invalid"),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*option`),
+			},
+			{
+				Config:      testDynamoDBMarshalFunctionConfig(`{ data = "not base64" }`, "binary:data"),
+				ExpectError: regexache.MustCompile(`illegal[\s\n]*base64[\s\n]*data`),
+			},
+		},
+	})
+}
+
+func testDynamoDBMarshalFunctionConfig(item string, options ...string) string {
+	args := []string{item}
+	for _, v := range options {
+		args = append(args, fmt.Sprintf("%q", v))
+	}
+
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::dynamodb_marshal(%[1]s)
+}
+`, strings.Join(args, ", "))
+}
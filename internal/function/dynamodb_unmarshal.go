@@ -0,0 +1,211 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"unicode/utf8"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	dynamoDBUnmarshalOptionDecodeBinary = "decode_binary"
+)
+
+var _ function.Function = dynamoDBUnmarshalFunction{}
+
+func NewDynamoDBUnmarshalFunction() function.Function {
+	return &dynamoDBUnmarshalFunction{}
+}
+
+type dynamoDBUnmarshalFunction struct{}
+
+func (f dynamoDBUnmarshalFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dynamodb_unmarshal"
+}
+
+func (f dynamoDBUnmarshalFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "dynamodb_unmarshal Function",
+		MarkdownDescription: "Converts a DynamoDB item in AttributeValue JSON format into an object",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "item",
+				MarkdownDescription: "DynamoDB item in AttributeValue JSON format",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name: "options",
+			MarkdownDescription: "Conversion options. Valid values are `decode_binary`, to return binary values as UTF-8 strings " +
+				"rather than base64-encoded strings",
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (f dynamoDBUnmarshalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var item string
+	var options []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &item, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	var u dynamoDBUnmarshaler
+	for _, option := range options {
+		switch option {
+		case dynamoDBUnmarshalOptionDecodeBinary:
+			u.decodeBinary = true
+		default:
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("unsupported option (%s)", option)))
+			return
+		}
+	}
+
+	attributes, err := tfdynamodb.ExpandTableItemAttributes(item)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, err := u.unmarshalMap(ctx, attributes)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.DynamicValue(result)))
+}
+
+// dynamoDBUnmarshaler converts DynamoDB attribute values to Terraform values.
+type dynamoDBUnmarshaler struct {
+	// decodeBinary returns binary values as UTF-8 strings rather than base64-encoded strings.
+	decodeBinary bool
+}
+
+func (u dynamoDBUnmarshaler) unmarshal(ctx context.Context, av awstypes.AttributeValue) (attr.Value, error) {
+	switch av := av.(type) {
+	case *awstypes.AttributeValueMemberB:
+		s, err := u.binaryString(av.Value)
+		if err != nil {
+			return nil, err
+		}
+		return types.StringValue(s), nil
+	case *awstypes.AttributeValueMemberBOOL:
+		return types.BoolValue(av.Value), nil
+	case *awstypes.AttributeValueMemberBS:
+		elements := make([]attr.Value, len(av.Value))
+		for i, v := range av.Value {
+			s, err := u.binaryString(v)
+			if err != nil {
+				return nil, err
+			}
+			elements[i] = types.StringValue(s)
+		}
+		return dynamoDBSetValue(types.StringType, elements)
+	case *awstypes.AttributeValueMemberL:
+		elementTypes := make([]attr.Type, len(av.Value))
+		elements := make([]attr.Value, len(av.Value))
+		for i, v := range av.Value {
+			v, err := u.unmarshal(ctx, v)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			elementTypes[i] = v.Type(ctx)
+			elements[i] = v
+		}
+		v, d := types.TupleValue(elementTypes, elements)
+		if d.HasError() {
+			return nil, fwdiag.DiagnosticsError(d)
+		}
+		return v, nil
+	case *awstypes.AttributeValueMemberM:
+		return u.unmarshalMap(ctx, av.Value)
+	case *awstypes.AttributeValueMemberN:
+		n, err := dynamoDBNumberValue(av.Value)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	case *awstypes.AttributeValueMemberNS:
+		elements, err := tfslices.ApplyToAllWithError(av.Value, func(v string) (attr.Value, error) {
+			return dynamoDBNumberValue(v)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return dynamoDBSetValue(types.NumberType, elements)
+	case *awstypes.AttributeValueMemberNULL:
+		return types.StringNull(), nil
+	case *awstypes.AttributeValueMemberS:
+		return types.StringValue(av.Value), nil
+	case *awstypes.AttributeValueMemberSS:
+		return dynamoDBSetValue(types.StringType, tfslices.ApplyToAll(av.Value, func(v string) attr.Value {
+			return types.StringValue(v)
+		}))
+	default:
+		return nil, fmt.Errorf("unexpected attribute type: %T", av)
+	}
+}
+
+func (u dynamoDBUnmarshaler) unmarshalMap(ctx context.Context, apiObject map[string]awstypes.AttributeValue) (attr.Value, error) {
+	attributeTypes := make(map[string]attr.Type, len(apiObject))
+	attributes := make(map[string]attr.Value, len(apiObject))
+	for k, v := range apiObject {
+		v, err := u.unmarshal(ctx, v)
+		if err != nil {
+			return nil, fmt.Errorf("attribute (%s): %w", k, err)
+		}
+		attributeTypes[k] = v.Type(ctx)
+		attributes[k] = v
+	}
+
+	v, d := types.ObjectValue(attributeTypes, attributes)
+	if d.HasError() {
+		return nil, fwdiag.DiagnosticsError(d)
+	}
+
+	return v, nil
+}
+
+func (u dynamoDBUnmarshaler) binaryString(b []byte) (string, error) {
+	if !u.decodeBinary {
+		return inttypes.Base64Encode(b), nil
+	}
+
+	if !utf8.Valid(b) {
+		return "", fmt.Errorf("binary value is not valid UTF-8")
+	}
+
+	return string(b), nil
+}
+
+func dynamoDBNumberValue(s string) (attr.Value, error) {
+	n, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+	if err != nil {
+		return nil, fmt.Errorf("parsing number (%s): %w", s, err)
+	}
+
+	return types.NumberValue(n), nil
+}
+
+func dynamoDBSetValue(elementType attr.Type, elements []attr.Value) (attr.Value, error) {
+	v, d := types.SetValue(elementType, elements)
+	if d.HasError() {
+		return nil, fwdiag.DiagnosticsError(d)
+	}
+
+	return v, nil
+}
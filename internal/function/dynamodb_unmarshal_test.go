@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestDynamoDBUnmarshalFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testDynamoDBUnmarshalFunctionConfig(`{"id":{"S":"example"},"count":{"N":"3"},"enabled":{"BOOL":true},"tags":{"SS":["a","b"]},"nested":{"M":{"values":{"L":[{"S":"x"},{"N":"1"}]}}},"data":{"B":"aGVsbG8="}}`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"id":      knownvalue.StringExact("example"),
+						"count":   knownvalue.NumberExact(big.NewFloat(3)),
+						"enabled": knownvalue.Bool(true),
+						"tags": knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("a"),
+							knownvalue.StringExact("b"),
+						}),
+						"nested": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"values": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.StringExact("x"),
+								knownvalue.NumberExact(big.NewFloat(1)),
+							}),
+						}),
+						"data": knownvalue.StringExact("aGVsbG8="),
+					})),
+				},
+			},
+			{
+				Config: testDynamoDBUnmarshalFunctionConfig(`{"data":{"B":"aGVsbG8="}}`, "decode_binary"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"data": knownvalue.StringExact("hello"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestDynamoDBUnmarshalFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testDynamoDBUnmarshalFunctionConfig(`{"id":{"X":"example"}}`),
+				ExpectError: regexache.MustCompile(`unexpected[\s\n]*raw[\s\n]*attribute[\s\n]*type`),
+			},
+			{
+				Config:      testDynamoDBUnmarshalFunctionConfig(`{"id":{"S":"example"}}`, "invalid"),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*option`),
+			},
+		},
+	})
+}
+
+func testDynamoDBUnmarshalFunctionConfig(item string, options ...string) string {
+	args := []string{fmt.Sprintf("%q", item)}
+	for _, v := range options {
+		args = append(args, fmt.Sprintf("%q", v))
+	}
+
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::dynamodb_unmarshal(%[1]s)
+}
+`, strings.Join(args, ", "))
+}
@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	// e.g. m7g, m7i-flex, u-6tb1, u7i-12tb, mac2-m2pro, mac-m4pro.
+	ec2InstanceTypeClassRegex = regexache.MustCompile(`^([a-z]+(?:-[0-9a-z]*[a-z])?)([0-9]+)([a-z]*)(?:-([0-9a-z]+))?$`)
+	// e.g. large, 2xlarge, metal, metal-24xl.
+	ec2InstanceTypeSizeRegex = regexache.MustCompile(`^(?:nano|micro|small|medium|large|[0-9]*xlarge|metal(?:-[0-9]+xl)?)$`)
+)
+
+var ec2InstanceTypeParseResultAttrTypes = map[string]attr.Type{
+	"family":     types.StringType,
+	"generation": types.Int64Type,
+	"attributes": types.ListType{ElemType: types.StringType},
+	"size":       types.StringType,
+	"is_metal":   types.BoolType,
+}
+
+var _ function.Function = ec2InstanceTypeParseFunction{}
+
+func NewEC2InstanceTypeParseFunction() function.Function {
+	return &ec2InstanceTypeParseFunction{}
+}
+
+type ec2InstanceTypeParseFunction struct{}
+
+func (f ec2InstanceTypeParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ec2_instance_type_parse"
+}
+
+func (f ec2InstanceTypeParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "ec2_instance_type_parse Function",
+		MarkdownDescription: "Parses an EC2 instance type into its family, generation, attributes and size. " +
+			"The instance type is not validated against the instance types offered by AWS",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "instance_type",
+				MarkdownDescription: "EC2 instance type to parse, for example `m7g.2xlarge`",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: ec2InstanceTypeParseResultAttrTypes,
+		},
+	}
+}
+
+func (f ec2InstanceTypeParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	parts, err := parseEC2InstanceType(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	attributes, d := types.ListValueFrom(ctx, types.StringType, parts.attributes)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"family":     types.StringValue(parts.family),
+		"generation": types.Int64Value(parts.generation),
+		"attributes": attributes,
+		"size":       types.StringValue(parts.size),
+		"is_metal":   types.BoolValue(parts.isMetal),
+	}
+
+	result, d := types.ObjectValue(ec2InstanceTypeParseResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type ec2InstanceType struct {
+	family     string
+	generation int64
+	attributes []string
+	size       string
+	isMetal    bool
+}
+
+// parseEC2InstanceType parses an instance type of the form
+// family generation [attributes] [-option] . size, e.g. m7g.2xlarge or m7i-flex.large.
+// Each attribute letter (e.g. g for AWS Graviton processors, d for instance store volumes)
+// is returned as a separate attribute, followed by any option (e.g. flex).
+// Hyphenated families (e.g. u-6tb, mac-m) don't use attribute letters, so their attributes
+// (e.g. pro in mac-m4pro) are returned as a single attribute.
+func parseEC2InstanceType(s string) (*ec2InstanceType, error) {
+	class, size, ok := strings.Cut(s, ".")
+	if !ok {
+		return nil, fmt.Errorf("instance type (%s) must be of the form family generation[attributes].size", s)
+	}
+
+	m := ec2InstanceTypeClassRegex.FindStringSubmatch(class)
+	if m == nil {
+		return nil, fmt.Errorf("instance type (%s) must be of the form family generation[attributes].size", s)
+	}
+
+	if !ec2InstanceTypeSizeRegex.MatchString(size) {
+		return nil, fmt.Errorf("instance type (%s) has an invalid size (%s)", s, size)
+	}
+
+	generation, err := strconv.ParseInt(m[2], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	family := m[1]
+	attributes := strings.Split(m[3], "")
+	if strings.Contains(family, "-") && m[3] != "" {
+		attributes = []string{m[3]}
+	}
+	if option := m[4]; option != "" {
+		attributes = append(attributes, option)
+	}
+
+	return &ec2InstanceType{
+		family:     family,
+		generation: generation,
+		attributes: attributes,
+		size:       size,
+		isMetal:    size == "metal" || strings.HasPrefix(size, "metal-"),
+	}, nil
+}
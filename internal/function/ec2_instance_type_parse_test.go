@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestEC2InstanceTypeParseFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEC2InstanceTypeParseFunctionConfig("m7g.2xlarge"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testEC2InstanceTypeParseFunctionResult("m", 7, []string{"g"}, "2xlarge", false)),
+				},
+			},
+			{
+				Config: testEC2InstanceTypeParseFunctionConfig("m7i-flex.large"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testEC2InstanceTypeParseFunctionResult("m", 7, []string{"i", "flex"}, "large", false)),
+				},
+			},
+			{
+				Config: testEC2InstanceTypeParseFunctionConfig("x2iedn.xlarge"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testEC2InstanceTypeParseFunctionResult("x", 2, []string{"i", "e", "d", "n"}, "xlarge", false)),
+				},
+			},
+			{
+				Config: testEC2InstanceTypeParseFunctionConfig("r7iz.metal-16xl"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testEC2InstanceTypeParseFunctionResult("r", 7, []string{"i", "z"}, "metal-16xl", true)),
+				},
+			},
+			{
+				Config: testEC2InstanceTypeParseFunctionConfig("t3.nano"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testEC2InstanceTypeParseFunctionResult("t", 3, []string{}, "nano", false)),
+				},
+			},
+			{
+				Config: testEC2InstanceTypeParseFunctionConfig("u-6tb1.metal"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testEC2InstanceTypeParseFunctionResult("u-6tb", 1, []string{}, "metal", true)),
+				},
+			},
+			{
+				Config: testEC2InstanceTypeParseFunctionConfig("mac2-m2pro.metal"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testEC2InstanceTypeParseFunctionResult("mac", 2, []string{"m2pro"}, "metal", true)),
+				},
+			},
+			{
+				Config: testEC2InstanceTypeParseFunctionConfig("mac-m4.metal"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testEC2InstanceTypeParseFunctionResult("mac-m", 4, []string{}, "metal", true)),
+				},
+			},
+			{
+				Config: testEC2InstanceTypeParseFunctionConfig("mac-m4pro.metal"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testEC2InstanceTypeParseFunctionResult("mac-m", 4, []string{"pro"}, "metal", true)),
+				},
+			},
+		},
+	})
+}
+
+func TestEC2InstanceTypeParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEC2InstanceTypeParseFunctionConfig("m7g"),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*of[\s\n]*the[\s\n]*form`),
+			},
+			{
+				Config:      testEC2InstanceTypeParseFunctionConfig("m7g.huge"),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*size`),
+			},
+		},
+	})
+}
+
+func testEC2InstanceTypeParseFunctionResult(family string, generation int64, attributes []string, size string, isMetal bool) knownvalue.Check {
+	var checks []knownvalue.Check
+	for _, v := range attributes {
+		checks = append(checks, knownvalue.StringExact(v))
+	}
+
+	return knownvalue.ObjectExact(map[string]knownvalue.Check{
+		"family":     knownvalue.StringExact(family),
+		"generation": knownvalue.Int64Exact(generation),
+		"attributes": knownvalue.ListExact(checks),
+		"size":       knownvalue.StringExact(size),
+		"is_metal":   knownvalue.Bool(isMetal),
+	})
+}
+
+func testEC2InstanceTypeParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::ec2_instance_type_parse(%[1]q)
+}
+`, arg)
+}
@@ -0,0 +1,678 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	iamPolicyDecisionAllow        = "Allow"
+	iamPolicyDecisionExplicitDeny = "ExplicitDeny"
+	iamPolicyDecisionImplicitDeny = "ImplicitDeny"
+)
+
+var iamPolicyEvaluateResultAttrTypes = map[string]attr.Type{
+	"decision":     types.StringType,
+	"matched_sids": types.ListType{ElemType: types.StringType},
+}
+
+var _ function.Function = iamPolicyEvaluateFunction{}
+
+func NewIAMPolicyEvaluateFunction() function.Function {
+	return &iamPolicyEvaluateFunction{}
+}
+
+type iamPolicyEvaluateFunction struct{}
+
+func (f iamPolicyEvaluateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_evaluate"
+}
+
+func (f iamPolicyEvaluateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_evaluate Function",
+		MarkdownDescription: "Evaluates IAM policy documents against a request without calling AWS. Returns the decision " +
+			"(`Allow`, `ImplicitDeny` or `ExplicitDeny`) and the Sids of the statements which determined it.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Identity and resource-based IAM policy documents in JSON format",
+			},
+			function.StringParameter{
+				Name:                "action",
+				MarkdownDescription: "Action to evaluate, for example `s3:GetObject`",
+			},
+			function.StringParameter{
+				Name:                "resource",
+				MarkdownDescription: "ARN of the resource to evaluate",
+			},
+			function.StringParameter{
+				Name: "principal",
+				MarkdownDescription: "Principal making the request, for example an IAM role ARN, an AWS account ID or a service principal. " +
+					"Only used to evaluate statements with a `Principal` or `NotPrincipal` element",
+			},
+			function.DynamicParameter{
+				Name:                "context",
+				AllowNullValue:      true,
+				MarkdownDescription: "Request context keys and their values. Values may be strings, numbers, booleans or lists of these",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: iamPolicyEvaluateResultAttrTypes,
+		},
+	}
+}
+
+func (f iamPolicyEvaluateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies []string
+	var action, resource, principal string
+	var dynamicContext types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies, &action, &resource, &principal, &dynamicContext))
+	if resp.Error != nil {
+		return
+	}
+
+	requestContext, err := expandIAMPolicyEvaluationContext(ctx, dynamicContext)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(4, err.Error()))
+		return
+	}
+
+	input := iamPolicyEvaluationInput{
+		action:    action,
+		resource:  resource,
+		principal: principal,
+		context:   requestContext,
+	}
+
+	var docs []*iamPolicyDocument
+	for i, policy := range policies {
+		doc, err := parseIAMPolicyDocument(policy)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("policy %d: %s", i, err)))
+			return
+		}
+		docs = append(docs, doc)
+	}
+
+	decision, sids, err := evaluateIAMPolicyDocuments(docs, input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	matchedSids, d := types.ListValueFrom(ctx, types.StringType, sids)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"decision":     types.StringValue(decision),
+		"matched_sids": matchedSids,
+	}
+
+	result, d := types.ObjectValue(iamPolicyEvaluateResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// iamPolicyEvaluationInput describes the request being evaluated.
+// Context keys are case-insensitive and are stored in lower case.
+type iamPolicyEvaluationInput struct {
+	action    string
+	resource  string
+	principal string
+	context   map[string][]string
+}
+
+// expandIAMPolicyEvaluationContext converts the context argument, an object or map
+// whose values are scalars or lists of scalars, into a map of lower case key to values.
+func expandIAMPolicyEvaluationContext(ctx context.Context, v types.Dynamic) (map[string][]string, error) {
+	requestContext := make(map[string][]string)
+
+	if v.IsNull() || v.IsUnderlyingValueNull() {
+		return requestContext, nil
+	}
+
+	var elements map[string]attr.Value
+	switch v := v.UnderlyingValue().(type) {
+	case types.Object:
+		elements = v.Attributes()
+	case types.Map:
+		elements = v.Elements()
+	default:
+		return nil, fmt.Errorf("context must be an object or map, got %s", v.Type(ctx))
+	}
+
+	for k, v := range elements {
+		var values []attr.Value
+		switch v := v.(type) {
+		case types.List:
+			values = v.Elements()
+		case types.Set:
+			values = v.Elements()
+		case types.Tuple:
+			values = v.Elements()
+		default:
+			values = []attr.Value{v}
+		}
+
+		key := strings.ToLower(k)
+		for _, v := range values {
+			switch v := v.(type) {
+			case types.String:
+				requestContext[key] = append(requestContext[key], v.ValueString())
+			case types.Number:
+				requestContext[key] = append(requestContext[key], v.ValueBigFloat().Text('f', -1))
+			case types.Bool:
+				requestContext[key] = append(requestContext[key], strconv.FormatBool(v.ValueBool()))
+			default:
+				return nil, fmt.Errorf("context key %q: unsupported value type %s", k, v.Type(ctx))
+			}
+		}
+	}
+
+	return requestContext, nil
+}
+
+// evaluateIAMPolicyDocuments applies the IAM policy evaluation logic to the
+// statements of all documents: an explicit deny in any statement overrides
+// any allow, and a request which is not explicitly allowed is implicitly denied.
+func evaluateIAMPolicyDocuments(docs []*iamPolicyDocument, input iamPolicyEvaluationInput) (string, []string, error) {
+	allowSids, denySids := []string{}, []string{}
+	var allowed, denied bool
+
+	for _, doc := range docs {
+		for _, statement := range doc.Statements {
+			ok, err := statement.matches(input)
+			if err != nil {
+				return "", nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			switch statement.Effect {
+			case "Allow":
+				allowed = true
+				if statement.Sid != "" {
+					allowSids = append(allowSids, statement.Sid)
+				}
+			case "Deny":
+				denied = true
+				if statement.Sid != "" {
+					denySids = append(denySids, statement.Sid)
+				}
+			}
+		}
+	}
+
+	switch {
+	case denied:
+		return iamPolicyDecisionExplicitDeny, denySids, nil
+	case allowed:
+		return iamPolicyDecisionAllow, allowSids, nil
+	default:
+		return iamPolicyDecisionImplicitDeny, []string{}, nil
+	}
+}
+
+// matches returns whether the statement applies to the request.
+func (s *iamPolicyStatement) matches(input iamPolicyEvaluationInput) (bool, error) {
+	switch {
+	case len(s.Actions) > 0:
+		if !slices.ContainsFunc(s.Actions, func(v string) bool { return wildcardMatchFold(v, input.action) }) {
+			return false, nil
+		}
+	case len(s.NotActions) > 0:
+		if slices.ContainsFunc(s.NotActions, func(v string) bool { return wildcardMatchFold(v, input.action) }) {
+			return false, nil
+		}
+	}
+
+	switch {
+	case len(s.Resources) > 0:
+		if !slices.ContainsFunc(s.Resources, func(v string) bool { return iamPolicyResourceMatch(v, input) }) {
+			return false, nil
+		}
+	case len(s.NotResources) > 0:
+		if slices.ContainsFunc(s.NotResources, func(v string) bool { return iamPolicyResourceMatch(v, input) }) {
+			return false, nil
+		}
+	}
+
+	switch {
+	case s.Principals != nil:
+		if !s.Principals.matches(input.principal) {
+			return false, nil
+		}
+	case s.NotPrincipals != nil:
+		if s.NotPrincipals.matches(input.principal) {
+			return false, nil
+		}
+	}
+
+	for operator, keys := range s.Conditions {
+		for key, values := range keys {
+			ok, err := evaluateIAMPolicyCondition(operator, key, values, input)
+			if err != nil {
+				return false, fmt.Errorf("statement (%s): %w", s.Sid, err)
+			}
+			if !ok {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+func iamPolicyResourceMatch(pattern string, input iamPolicyEvaluationInput) bool {
+	pattern, ok := expandIAMPolicyVariables(pattern, input.context)
+	if !ok {
+		return false
+	}
+
+	return wildcardMatch(pattern, input.resource)
+}
+
+var accountIDRegexp = regexache.MustCompile(`^[0-9]{12}$`)
+
+// matches returns whether the principal is one of the principals in the set.
+func (p iamPolicyPrincipals) matches(principal string) bool {
+	if p.isWildcard() {
+		return true
+	}
+
+	if principal == "" {
+		return false
+	}
+
+	for principalType, ids := range p {
+		for _, id := range ids {
+			if principalType == "AWS" {
+				if iamPolicyAWSPrincipalMatch(id, principal) {
+					return true
+				}
+				continue
+			}
+
+			if strings.EqualFold(id, principal) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// iamPolicyAWSPrincipalMatch compares an "AWS" principal from a policy with the request principal.
+// An account ID or account root user ARN matches every principal in that account,
+// and an IAM role ARN matches sessions of that role.
+func iamPolicyAWSPrincipalMatch(id, principal string) bool { // nosemgrep:ci.aws-in-func-name
+	if id == "*" || id == principal {
+		return true
+	}
+
+	principalARN, err := arn.Parse(principal)
+	if err != nil {
+		return false
+	}
+
+	if accountIDRegexp.MatchString(id) {
+		return principalARN.AccountID == id
+	}
+
+	idARN, err := arn.Parse(id)
+	if err != nil || idARN.Service != "iam" || idARN.AccountID != principalARN.AccountID || idARN.Partition != principalARN.Partition {
+		return false
+	}
+
+	if idARN.Resource == "root" {
+		return true
+	}
+
+	// arn:aws:sts::123456789012:assumed-role/role-name/session-name
+	if principalARN.Service == "sts" && strings.HasPrefix(principalARN.Resource, "assumed-role/") && strings.HasPrefix(idARN.Resource, "role/") {
+		roleName, _, _ := strings.Cut(strings.TrimPrefix(principalARN.Resource, "assumed-role/"), "/")
+		parts := strings.Split(idARN.Resource, "/")
+
+		return parts[len(parts)-1] == roleName
+	}
+
+	return false
+}
+
+// evaluateIAMPolicyCondition evaluates a single condition key against the request context.
+// Operators may be qualified with the ForAllValues: or ForAnyValue: set operators and
+// the IfExists suffix.
+func evaluateIAMPolicyCondition(operator, key string, values []string, input iamPolicyEvaluationInput) (bool, error) {
+	baseOperator := operator
+	forAllValues := strings.HasPrefix(baseOperator, "ForAllValues:")
+	forAnyValue := strings.HasPrefix(baseOperator, "ForAnyValue:")
+	baseOperator = strings.TrimPrefix(strings.TrimPrefix(baseOperator, "ForAllValues:"), "ForAnyValue:")
+	ifExists := strings.HasSuffix(baseOperator, "IfExists")
+	baseOperator = strings.TrimSuffix(baseOperator, "IfExists")
+
+	contextValues, exists := input.context[strings.ToLower(key)]
+
+	if baseOperator == "Null" {
+		if len(values) != 1 {
+			return false, fmt.Errorf("condition %s: %s: expected a single value", operator, key)
+		}
+
+		return strconv.FormatBool(!exists) == strings.ToLower(values[0]), nil
+	}
+
+	match, negated, err := iamPolicyConditionOperatorMatchFunc(baseOperator)
+	if err != nil {
+		return false, fmt.Errorf("condition %s: %w", operator, err)
+	}
+
+	if !exists {
+		return ifExists || forAllValues || negated, nil
+	}
+
+	// Policy variables are resolved against the request context before comparison.
+	var policyValues []string
+	for _, v := range values {
+		if v, ok := expandIAMPolicyVariables(v, input.context); ok {
+			policyValues = append(policyValues, v)
+		}
+	}
+
+	result := func(contextValue string) (bool, error) {
+		for _, v := range policyValues {
+			ok, err := match(v, contextValue)
+			if err != nil {
+				return false, fmt.Errorf("condition %s: %s: %w", operator, key, err)
+			}
+			if ok {
+				return !negated, nil
+			}
+		}
+
+		return negated, nil
+	}
+
+	switch {
+	case forAllValues, negated && !forAnyValue:
+		for _, v := range contextValues {
+			ok, err := result(v)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+
+		return true, nil
+	default:
+		for _, v := range contextValues {
+			ok, err := result(v)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+
+		return false, nil
+	}
+}
+
+type iamPolicyConditionMatchFunc func(policyValue, contextValue string) (bool, error)
+
+// iamPolicyConditionOperatorMatchFunc returns the positive form of a condition operator's
+// comparison and whether the operator is negated.
+func iamPolicyConditionOperatorMatchFunc(operator string) (iamPolicyConditionMatchFunc, bool, error) {
+	switch operator {
+	case "StringEquals", "BinaryEquals":
+		return matchIAMPolicyConditionString, false, nil
+	case "StringNotEquals":
+		return matchIAMPolicyConditionString, true, nil
+	case "StringEqualsIgnoreCase":
+		return matchIAMPolicyConditionStringFold, false, nil
+	case "StringNotEqualsIgnoreCase":
+		return matchIAMPolicyConditionStringFold, true, nil
+	case "StringLike", "ArnEquals", "ArnLike":
+		return matchIAMPolicyConditionStringLike, false, nil
+	case "StringNotLike", "ArnNotEquals", "ArnNotLike":
+		return matchIAMPolicyConditionStringLike, true, nil
+	case "NumericEquals":
+		return matchIAMPolicyConditionNumeric(func(c int) bool { return c == 0 }), false, nil
+	case "NumericNotEquals":
+		return matchIAMPolicyConditionNumeric(func(c int) bool { return c == 0 }), true, nil
+	case "NumericLessThan":
+		return matchIAMPolicyConditionNumeric(func(c int) bool { return c < 0 }), false, nil
+	case "NumericLessThanEquals":
+		return matchIAMPolicyConditionNumeric(func(c int) bool { return c <= 0 }), false, nil
+	case "NumericGreaterThan":
+		return matchIAMPolicyConditionNumeric(func(c int) bool { return c > 0 }), false, nil
+	case "NumericGreaterThanEquals":
+		return matchIAMPolicyConditionNumeric(func(c int) bool { return c >= 0 }), false, nil
+	case "DateEquals":
+		return matchIAMPolicyConditionDate(func(c int) bool { return c == 0 }), false, nil
+	case "DateNotEquals":
+		return matchIAMPolicyConditionDate(func(c int) bool { return c == 0 }), true, nil
+	case "DateLessThan":
+		return matchIAMPolicyConditionDate(func(c int) bool { return c < 0 }), false, nil
+	case "DateLessThanEquals":
+		return matchIAMPolicyConditionDate(func(c int) bool { return c <= 0 }), false, nil
+	case "DateGreaterThan":
+		return matchIAMPolicyConditionDate(func(c int) bool { return c > 0 }), false, nil
+	case "DateGreaterThanEquals":
+		return matchIAMPolicyConditionDate(func(c int) bool { return c >= 0 }), false, nil
+	case "Bool":
+		return matchIAMPolicyConditionStringFold, false, nil
+	case "IpAddress":
+		return matchIAMPolicyConditionIPAddress, false, nil
+	case "NotIpAddress":
+		return matchIAMPolicyConditionIPAddress, true, nil
+	default:
+		return nil, false, fmt.Errorf("unsupported condition operator")
+	}
+}
+
+func matchIAMPolicyConditionString(policyValue, contextValue string) (bool, error) {
+	return iamPolicyLiteralReplacer.Replace(policyValue) == contextValue, nil
+}
+
+func matchIAMPolicyConditionStringFold(policyValue, contextValue string) (bool, error) {
+	return strings.EqualFold(iamPolicyLiteralReplacer.Replace(policyValue), contextValue), nil
+}
+
+func matchIAMPolicyConditionStringLike(policyValue, contextValue string) (bool, error) {
+	return wildcardMatch(policyValue, contextValue), nil
+}
+
+// matchIAMPolicyConditionNumeric returns a match function which compares the
+// context value with the policy value, cmp(contextValue, policyValue).
+func matchIAMPolicyConditionNumeric(f func(int) bool) iamPolicyConditionMatchFunc {
+	return func(policyValue, contextValue string) (bool, error) {
+		p, err := strconv.ParseFloat(policyValue, 64)
+		if err != nil {
+			return false, err
+		}
+		c, err := strconv.ParseFloat(contextValue, 64)
+		if err != nil {
+			return false, err
+		}
+
+		switch {
+		case c < p:
+			return f(-1), nil
+		case c > p:
+			return f(1), nil
+		default:
+			return f(0), nil
+		}
+	}
+}
+
+// matchIAMPolicyConditionDate returns a match function which compares the
+// context value with the policy value, cmp(contextValue, policyValue).
+// Dates are either RFC 3339 timestamps or seconds since the Unix epoch.
+func matchIAMPolicyConditionDate(f func(int) bool) iamPolicyConditionMatchFunc {
+	parse := func(s string) (time.Time, error) {
+		if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return time.Unix(v, 0), nil
+		}
+		if v, err := time.Parse(time.RFC3339, s); err == nil {
+			return v, nil
+		}
+
+		return time.Parse(time.DateOnly, s)
+	}
+
+	return func(policyValue, contextValue string) (bool, error) {
+		p, err := parse(policyValue)
+		if err != nil {
+			return false, err
+		}
+		c, err := parse(contextValue)
+		if err != nil {
+			return false, err
+		}
+
+		return f(c.Compare(p)), nil
+	}
+}
+
+func matchIAMPolicyConditionIPAddress(policyValue, contextValue string) (bool, error) {
+	addr, err := netip.ParseAddr(contextValue)
+	if err != nil {
+		return false, err
+	}
+
+	if !strings.Contains(policyValue, "/") {
+		v, err := netip.ParseAddr(policyValue)
+		if err != nil {
+			return false, err
+		}
+
+		return v == addr, nil
+	}
+
+	prefix, err := netip.ParsePrefix(policyValue)
+	if err != nil {
+		return false, err
+	}
+
+	return prefix.Contains(addr), nil
+}
+
+var iamPolicyVariableRegexp = regexache.MustCompile(`\$\{([^}]+)\}`)
+
+// ${*} and ${?} expand to marker runes from the Unicode private use area so that
+// wildcardMatch matches them as literal characters rather than as wildcards.
+const (
+	iamPolicyLiteralAsterisk     = '\uE000'
+	iamPolicyLiteralQuestionMark = '\uE001'
+)
+
+// iamPolicyLiteralReplacer replaces the marker runes with the literal characters they stand for.
+var iamPolicyLiteralReplacer = strings.NewReplacer(string(iamPolicyLiteralAsterisk), "*", string(iamPolicyLiteralQuestionMark), "?")
+
+// expandIAMPolicyVariables substitutes policy variables, such as ${aws:username}, with
+// values from the request context. ${*} and ${?} are replaced by markers for the literal
+// characters, ${$} is replaced by a literal '$', and a default value may be specified as
+// ${key, 'default'}.
+// Returns false if a variable cannot be resolved.
+func expandIAMPolicyVariables(s string, requestContext map[string][]string) (string, bool) {
+	ok := true
+
+	result := iamPolicyVariableRegexp.ReplaceAllStringFunc(s, func(m string) string {
+		name := m[2 : len(m)-1]
+
+		switch name {
+		case "*":
+			return string(iamPolicyLiteralAsterisk)
+		case "?":
+			return string(iamPolicyLiteralQuestionMark)
+		case "$":
+			return name
+		}
+
+		name, defaultValue, hasDefault := strings.Cut(name, ",")
+		if v, exists := requestContext[strings.ToLower(strings.TrimSpace(name))]; exists && len(v) == 1 {
+			return v[0]
+		}
+
+		if hasDefault {
+			return strings.Trim(strings.TrimSpace(defaultValue), "'")
+		}
+
+		ok = false
+
+		return m
+	})
+
+	return result, ok
+}
+
+// wildcardMatch reports whether s matches pattern, where '*' in the pattern
+// matches any sequence of characters and '?' matches any single character.
+// Marker runes from expandIAMPolicyVariables match the literal characters.
+func wildcardMatch(pattern, s string) bool {
+	p, v := []rune(pattern), []rune(s)
+	pi, vi := 0, 0
+	star, match := -1, 0
+
+	literal := func(r rune) rune {
+		switch r {
+		case iamPolicyLiteralAsterisk:
+			return '*'
+		case iamPolicyLiteralQuestionMark:
+			return '?'
+		default:
+			return r
+		}
+	}
+
+	for vi < len(v) {
+		switch {
+		case pi < len(p) && p[pi] == '*':
+			star = pi
+			match = vi
+			pi++
+		case pi < len(p) && (p[pi] == '?' || literal(p[pi]) == v[vi]):
+			pi++
+			vi++
+		case star != -1:
+			pi = star + 1
+			match++
+			vi = match
+		default:
+			return false
+		}
+	}
+
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+
+	return pi == len(p)
+}
+
+// wildcardMatchFold is a case-insensitive wildcardMatch.
+func wildcardMatchFold(pattern, s string) bool {
+	return wildcardMatch(strings.ToLower(pattern), strings.ToLower(s))
+}
@@ -0,0 +1,257 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+const testIAMPolicyEvaluateFunctionPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowRead",
+      "Effect": "Allow",
+      "Principal": {"AWS": "444455556666"},
+      "Action": "s3:Get*",
+      "Resource": "arn:aws:s3:::example/*"
+    },
+    {
+      "Sid": "DenyDelete",
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "s3:DeleteObject",
+      "Resource": "arn:aws:s3:::example/*"
+    },
+    {
+      "Sid": "DenyInsecureTransport",
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "s3:*",
+      "Resource": "*",
+      "Condition": {"Bool": {"aws:SecureTransport": "false"}}
+    }
+  ]
+}`
+
+func TestIAMPolicyEvaluateFunction_allow(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig("s3:GetObject", "arn:aws:s3:::example/key", "arn:aws:iam::444455556666:role/example", `{ "aws:SecureTransport" = true }`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"decision": knownvalue.StringExact("Allow"),
+						"matched_sids": knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("AllowRead"),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEvaluateFunction_explicitDeny(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig("s3:GetObject", "arn:aws:s3:::example/key", "arn:aws:iam::444455556666:role/example", `{ "aws:SecureTransport" = false }`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"decision": knownvalue.StringExact("ExplicitDeny"),
+						"matched_sids": knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("DenyInsecureTransport"),
+						}),
+					})),
+				},
+			},
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig("s3:DeleteObject", "arn:aws:s3:::example/key", "arn:aws:iam::444455556666:role/example", "null"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"decision": knownvalue.StringExact("ExplicitDeny"),
+						"matched_sids": knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("DenyDelete"),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEvaluateFunction_implicitDeny(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig("s3:GetObject", "arn:aws:s3:::example/key", "arn:aws:iam::111122223333:role/example", "{}"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"decision":     knownvalue.StringExact("ImplicitDeny"),
+						"matched_sids": knownvalue.ListSizeExact(0),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEvaluateFunction_literalWildcardVariable(t *testing.T) {
+	t.Parallel()
+
+	policy := `{"Version":"2012-10-17","Statement":[{"Sid":"AllowLiteral","Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/${*}"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig_policy(policy, "s3:GetObject", "arn:aws:s3:::example/abc"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"decision":     knownvalue.StringExact("ImplicitDeny"),
+						"matched_sids": knownvalue.ListSizeExact(0),
+					})),
+				},
+			},
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig_policy(policy, "s3:GetObject", "arn:aws:s3:::example/*"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"decision": knownvalue.StringExact("Allow"),
+						"matched_sids": knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("AllowLiteral"),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEvaluateFunction_valueContainsWildcard(t *testing.T) {
+	t.Parallel()
+
+	policy := `{"Version":"2012-10-17","Statement":[{"Sid":"AllowPrefix","Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig_policy(policy, "s3:GetObject", "arn:aws:s3:::example/*x"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"decision": knownvalue.StringExact("Allow"),
+						"matched_sids": knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("AllowPrefix"),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestWildcardMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern  string
+		value    string
+		expected bool
+	}{
+		{pattern: "*", value: "", expected: true},
+		{pattern: "*", value: "abc", expected: true},
+		{pattern: "a?c", value: "abc", expected: true},
+		{pattern: "a?c", value: "ac", expected: false},
+		{pattern: "a*c", value: "abbc", expected: true},
+		{pattern: "a*c", value: "abd", expected: false},
+		{pattern: "*", value: "*x", expected: true},
+		{pattern: "a*c", value: "a*bc", expected: true},
+		{pattern: "x*", value: "x**y", expected: true},
+		{pattern: "*y", value: "x**y", expected: true},
+		{pattern: "a?c", value: "a*c", expected: true},
+		{pattern: "a*", value: "b*", expected: false},
+		{pattern: "*b*", value: "a*c", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.pattern+" "+testCase.value, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tffunction.WildcardMatch(testCase.pattern, testCase.value), testCase.expected; got != want {
+				t.Errorf("WildcardMatch(%q, %q) = %t, want %t", testCase.pattern, testCase.value, got, want)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyEvaluateFunction_invalidContext(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyEvaluateFunctionConfig("s3:GetObject", "arn:aws:s3:::example/key", "", `"invalid"`),
+				ExpectError: regexache.MustCompile(`context[\s\n]*must[\s\n]*be[\s\n]*an[\s\n]*object`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyEvaluateFunctionConfig(action, resource, principal, context string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_evaluate([%[1]q], %[2]q, %[3]q, %[4]q, %[5]s)
+}
+`, testIAMPolicyEvaluateFunctionPolicy, action, resource, principal, context)
+}
+
+func testIAMPolicyEvaluateFunctionConfig_policy(policy, action, resource string) string {
+	// Escape policy variables so that Terraform does not interpolate them.
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_evaluate([%[1]s], %[2]q, %[3]q, "", null)
+}
+`, strings.ReplaceAll(strconv.Quote(policy), "${", "$${"), action, resource)
+}
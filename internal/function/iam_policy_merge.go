@@ -0,0 +1,207 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges a list of IAM policy documents into a single policy document. Identical statements are " +
+			"de-duplicated, and an error is returned if two statements with the same Sid differ.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				ElementType:         types.StringType,
+				MarkdownDescription: "IAM policy documents in JSON format, merged in the order specified",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := mergeIAMPolicyDocuments(policies)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// mergeIAMPolicyDocuments merges policy documents in order using the same
+// model as the aws_iam_policy_document data source. As with that data source,
+// the last non-empty Id is kept and the latest Version wins.
+func mergeIAMPolicyDocuments(policies []string) (string, error) {
+	mergedDoc := &tfiam.IAMPolicyDoc{}
+
+	// canonical JSON of each statement merged so far, keyed by Sid
+	sids := make(map[string]string)
+	// canonical JSON of each statement merged so far
+	seen := make(map[string]struct{})
+
+	for i, policy := range policies {
+		doc, err := parseIAMPolicyDocument(policy)
+		if err != nil {
+			return "", fmt.Errorf("merging policy %d: %w", i, err)
+		}
+
+		sourceDoc := &tfiam.IAMPolicyDoc{
+			Version: doc.Version,
+			Id:      doc.Id,
+		}
+		for j, statement := range doc.Statements {
+			b, err := marshalIAMPolicyJSON(statement)
+			if err != nil {
+				return "", fmt.Errorf("merging policy %d: statement %d: %w", i, j, err)
+			}
+			key := string(b)
+
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			if statement.Sid != "" {
+				if v, ok := sids[statement.Sid]; ok && v != key {
+					return "", fmt.Errorf("merging policy %d: statement %d: conflicting Sid (%s): statements with the same Sid must be identical", i, j, statement.Sid)
+				}
+				sids[statement.Sid] = key
+			}
+
+			seen[key] = struct{}{}
+			sourceDoc.Statements = append(sourceDoc.Statements, statement.iamPolicyStatement())
+		}
+
+		mergedDoc.Merge(sourceDoc)
+	}
+
+	b, err := marshalIAMPolicyJSON(mergedDoc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(unescapeHTMLInJSON(b)), nil
+}
+
+// unescapeHTMLInJSON reverses the escaping of the HTML characters <, > and &
+// applied by json.Marshal within the iam package's MarshalJSON methods.
+func unescapeHTMLInJSON(b []byte) []byte {
+	var buf bytes.Buffer
+
+	for i := 0; i < len(b); i++ {
+		if b[i] != '\\' || i+1 >= len(b) {
+			buf.WriteByte(b[i])
+			continue
+		}
+
+		if b[i+1] == 'u' && i+6 <= len(b) {
+			switch string(b[i+2 : i+6]) {
+			case "003c":
+				buf.WriteByte('<')
+				i += 5
+				continue
+			case "003e":
+				buf.WriteByte('>')
+				i += 5
+				continue
+			case "0026":
+				buf.WriteByte('&')
+				i += 5
+				continue
+			}
+		}
+
+		// Copy any other escape sequence, including an escaped backslash, unchanged.
+		buf.Write(b[i : i+2])
+		i++
+	}
+
+	return buf.Bytes()
+}
+
+// iamPolicyStatement converts the statement to the iam package's policy statement model.
+func (s *iamPolicyStatement) iamPolicyStatement() *tfiam.IAMPolicyStatement {
+	statement := &tfiam.IAMPolicyStatement{
+		Sid:           s.Sid,
+		Effect:        s.Effect,
+		Actions:       s.Actions.value(),
+		NotActions:    s.NotActions.value(),
+		Resources:     s.Resources.value(),
+		NotResources:  s.NotResources.value(),
+		Principals:    s.Principals.iamPolicyStatementPrincipalSet(),
+		NotPrincipals: s.NotPrincipals.iamPolicyStatementPrincipalSet(),
+	}
+
+	for _, test := range slices.Sorted(maps.Keys(s.Conditions)) {
+		for _, variable := range slices.Sorted(maps.Keys(s.Conditions[test])) {
+			statement.Conditions = append(statement.Conditions, tfiam.IAMPolicyStatementCondition{
+				Test:     test,
+				Variable: variable,
+				Values:   slices.Clone([]string(s.Conditions[test][variable])),
+			})
+		}
+	}
+
+	return statement
+}
+
+// iamPolicyStatementPrincipalSet converts the principals to the iam package's principal model.
+func (p iamPolicyPrincipals) iamPolicyStatementPrincipalSet() tfiam.IAMPolicyStatementPrincipalSet {
+	if p.isWildcard() {
+		return tfiam.IAMPolicyStatementPrincipalSet{{Type: "*", Identifiers: "*"}}
+	}
+
+	var principals tfiam.IAMPolicyStatementPrincipalSet
+	for _, typ := range slices.Sorted(maps.Keys(p)) {
+		principals = append(principals, tfiam.IAMPolicyStatementPrincipal{
+			Type:        typ,
+			Identifiers: p[typ].value(),
+		})
+	}
+
+	return principals
+}
+
+// value returns the set as the iam package's policy model represents element values:
+// nil if empty, a string if it has exactly one element, otherwise a list of strings.
+func (s iamPolicyStringSet) value() any {
+	switch len(s) {
+	case 0:
+		return nil
+	case 1:
+		return s[0]
+	default:
+		return slices.Clone([]string(s))
+	}
+}
@@ -0,0 +1,379 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = iamPolicyNormalizeFunction{}
+
+func NewIAMPolicyNormalizeFunction() function.Function {
+	return &iamPolicyNormalizeFunction{}
+}
+
+type iamPolicyNormalizeFunction struct{}
+
+func (f iamPolicyNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_normalize"
+}
+
+func (f iamPolicyNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_normalize Function",
+		MarkdownDescription: "Normalizes an IAM policy document into a canonical JSON form. Statements are sorted, " +
+			"single element lists are collapsed to strings, and multi-element lists are sorted and de-duplicated. " +
+			"Equivalent principals are rewritten to a single form: `{\"AWS\": \"*\"}` becomes `\"*\"` and " +
+			"account root user ARNs become account IDs.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	doc, err := parseIAMPolicyDocument(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	if err := doc.sortStatements(); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, err := doc.String()
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// iamPolicyDocument is a canonical representation of an IAM policy document.
+// Field order matches the order in which AWS conventionally renders policy
+// elements, with Version first.
+type iamPolicyDocument struct {
+	Version    string                `json:",omitempty"`
+	Id         string                `json:",omitempty"`
+	Statements []*iamPolicyStatement `json:"Statement,omitempty"`
+}
+
+// iamPolicyStatement is a canonical representation of a single IAM policy statement.
+// List-valued elements hold sorted, de-duplicated values.
+type iamPolicyStatement struct {
+	Sid           string                                   `json:",omitempty"`
+	Effect        string                                   `json:",omitempty"`
+	Principals    iamPolicyPrincipals                      `json:"Principal,omitempty"`
+	NotPrincipals iamPolicyPrincipals                      `json:"NotPrincipal,omitempty"`
+	Actions       iamPolicyStringSet                       `json:"Action,omitempty"`
+	NotActions    iamPolicyStringSet                       `json:"NotAction,omitempty"`
+	Resources     iamPolicyStringSet                       `json:"Resource,omitempty"`
+	NotResources  iamPolicyStringSet                       `json:"NotResource,omitempty"`
+	Conditions    map[string]map[string]iamPolicyStringSet `json:"Condition,omitempty"`
+}
+
+// iamPolicyStringSet is a sorted, de-duplicated list of strings which is
+// rendered as a single string when it has exactly one element.
+type iamPolicyStringSet []string
+
+func (s iamPolicyStringSet) MarshalJSON() ([]byte, error) {
+	if len(s) == 1 {
+		return marshalIAMPolicyJSON(s[0])
+	}
+
+	return marshalIAMPolicyJSON([]string(s))
+}
+
+// iamPolicyPrincipals holds statement principals keyed by principal type.
+// The wildcard principal ("Principal": "*" or {"AWS": "*"}) is stored under the "*" key.
+type iamPolicyPrincipals map[string]iamPolicyStringSet
+
+func (p iamPolicyPrincipals) MarshalJSON() ([]byte, error) {
+	if p.isWildcard() {
+		return []byte(`"*"`), nil
+	}
+
+	return marshalIAMPolicyJSON(map[string]iamPolicyStringSet(p))
+}
+
+func (p iamPolicyPrincipals) isWildcard() bool {
+	if len(p) != 1 {
+		return false
+	}
+
+	v, ok := p["*"]
+
+	return ok && len(v) == 1 && v[0] == "*"
+}
+
+// String returns the compact canonical JSON representation of the policy document.
+func (d *iamPolicyDocument) String() (string, error) {
+	b, err := marshalIAMPolicyJSON(d)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// marshalIAMPolicyJSON is json.Marshal without escaping of HTML characters,
+// which commonly appear in condition values.
+func marshalIAMPolicyJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSpace(buf.Bytes()), nil
+}
+
+// sortStatements orders statements by their canonical JSON representation.
+func (d *iamPolicyDocument) sortStatements() error {
+	keys := make(map[*iamPolicyStatement]string, len(d.Statements))
+	for _, s := range d.Statements {
+		b, err := json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		keys[s] = string(b)
+	}
+
+	slices.SortStableFunc(d.Statements, func(a, b *iamPolicyStatement) int {
+		return strings.Compare(keys[a], keys[b])
+	})
+
+	return nil
+}
+
+// parseIAMPolicyDocument parses a JSON IAM policy document into its canonical form.
+// Statement order is preserved.
+func parseIAMPolicyDocument(s string) (*iamPolicyDocument, error) {
+	var raw map[string]any
+
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	if err := dec.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	doc := &iamPolicyDocument{}
+
+	var ok bool
+	for k, v := range raw {
+		switch k {
+		case "Version":
+			if doc.Version, ok = v.(string); !ok {
+				return nil, fmt.Errorf("parsing policy: unsupported Version type %T", v)
+			}
+		case "Id":
+			if doc.Id, ok = v.(string); !ok {
+				return nil, fmt.Errorf("parsing policy: unsupported Id type %T", v)
+			}
+		case "Statement":
+			var statements []any
+			switch v := v.(type) {
+			case []any:
+				statements = v
+			case map[string]any:
+				statements = []any{v}
+			default:
+				return nil, fmt.Errorf("parsing policy: unsupported Statement type %T", v)
+			}
+
+			for i, v := range statements {
+				m, ok := v.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("parsing policy: Statement[%d]: unsupported type %T", i, v)
+				}
+
+				statement, err := parseIAMPolicyStatement(m)
+				if err != nil {
+					return nil, fmt.Errorf("parsing policy: Statement[%d]: %w", i, err)
+				}
+
+				doc.Statements = append(doc.Statements, statement)
+			}
+		default:
+			return nil, fmt.Errorf("parsing policy: unsupported element %q", k)
+		}
+	}
+
+	return doc, nil
+}
+
+func parseIAMPolicyStatement(m map[string]any) (*iamPolicyStatement, error) {
+	statement := &iamPolicyStatement{}
+
+	var (
+		ok  bool
+		err error
+	)
+	for k, v := range m {
+		switch k {
+		case "Sid":
+			if statement.Sid, ok = v.(string); !ok {
+				return nil, fmt.Errorf("unsupported Sid type %T", v)
+			}
+		case "Effect":
+			effect, _ := v.(string)
+			switch {
+			case strings.EqualFold(effect, "Allow"):
+				statement.Effect = "Allow"
+			case strings.EqualFold(effect, "Deny"):
+				statement.Effect = "Deny"
+			default:
+				return nil, fmt.Errorf("unsupported Effect %q", effect)
+			}
+		case "Principal":
+			statement.Principals, err = parseIAMPolicyPrincipals(v)
+		case "NotPrincipal":
+			statement.NotPrincipals, err = parseIAMPolicyPrincipals(v)
+		case "Action":
+			statement.Actions, err = parseIAMPolicyStringSet(v)
+		case "NotAction":
+			statement.NotActions, err = parseIAMPolicyStringSet(v)
+		case "Resource":
+			statement.Resources, err = parseIAMPolicyStringSet(v)
+		case "NotResource":
+			statement.NotResources, err = parseIAMPolicyStringSet(v)
+		case "Condition":
+			statement.Conditions, err = parseIAMPolicyConditions(v)
+		default:
+			return nil, fmt.Errorf("unsupported element %q", k)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+	}
+
+	return statement, nil
+}
+
+func parseIAMPolicyPrincipals(v any) (iamPolicyPrincipals, error) {
+	switch v := v.(type) {
+	case string:
+		if v != "*" {
+			return nil, fmt.Errorf("unsupported value %q", v)
+		}
+		return iamPolicyPrincipals{"*": {"*"}}, nil
+	case map[string]any:
+		principals := make(iamPolicyPrincipals, len(v))
+		for k, v := range v {
+			ids, err := parseIAMPolicyStringSet(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			if k == "AWS" {
+				for i, id := range ids {
+					ids[i] = canonicalIAMPolicyAWSPrincipal(id)
+				}
+				slices.Sort(ids)
+				ids = slices.Compact(ids)
+			}
+			if len(ids) > 0 {
+				principals[k] = ids
+			}
+		}
+		// {"AWS": "*"} grants access to everyone, the same as "Principal": "*".
+		if ids, ok := principals["AWS"]; ok && len(principals) == 1 && len(ids) == 1 && ids[0] == "*" {
+			return iamPolicyPrincipals{"*": {"*"}}, nil
+		}
+		return principals, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %T", v)
+	}
+}
+
+// canonicalIAMPolicyAWSPrincipal rewrites an account root user ARN to its account ID,
+// the two forms being interchangeable in a policy.
+func canonicalIAMPolicyAWSPrincipal(id string) string { // nosemgrep:ci.aws-in-func-name
+	if v, err := arn.Parse(id); err == nil && v.Service == "iam" && v.Resource == "root" && accountIDRegexp.MatchString(v.AccountID) {
+		return v.AccountID
+	}
+
+	return id
+}
+
+func parseIAMPolicyConditions(v any) (map[string]map[string]iamPolicyStringSet, error) {
+	operators, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type %T", v)
+	}
+
+	conditions := make(map[string]map[string]iamPolicyStringSet, len(operators))
+	for operator, v := range operators {
+		keys, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s: unsupported type %T", operator, v)
+		}
+
+		conditions[operator] = make(map[string]iamPolicyStringSet, len(keys))
+		for key, v := range keys {
+			values, err := parseIAMPolicyStringSet(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s: %w", operator, key, err)
+			}
+			conditions[operator][key] = values
+		}
+	}
+
+	return conditions, nil
+}
+
+// parseIAMPolicyStringSet converts a policy element value, which may be a
+// single scalar or a list of scalars, into a sorted, de-duplicated list of strings.
+func parseIAMPolicyStringSet(v any) (iamPolicyStringSet, error) {
+	var values []any
+	switch v := v.(type) {
+	case []any:
+		values = v
+	default:
+		values = []any{v}
+	}
+
+	set := make(iamPolicyStringSet, 0, len(values))
+	for _, v := range values {
+		switch v := v.(type) {
+		case string:
+			set = append(set, v)
+		case json.Number:
+			set = append(set, v.String())
+		case bool:
+			set = append(set, strconv.FormatBool(v))
+		default:
+			return nil, fmt.Errorf("unsupported value type %T", v)
+		}
+	}
+
+	slices.Sort(set)
+
+	return slices.Compact(set), nil
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var regionInfoResultAttrTypes = map[string]attr.Type{
+	"partition":                types.StringType,
+	"dns_suffix":               types.StringType,
+	"dualstack_dns_suffix":     types.StringType,
+	"service_principal_suffix": types.StringType,
+	"opt_in":                   types.BoolType,
+}
+
+var _ function.Function = regionInfoFunction{}
+
+func NewRegionInfoFunction() function.Function {
+	return &regionInfoFunction{}
+}
+
+type regionInfoFunction struct{}
+
+func (f regionInfoFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "region_info"
+}
+
+func (f regionInfoFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "region_info Function",
+		MarkdownDescription: "Returns the partition, DNS suffixes, default service principal suffix and opt-in status for an AWS Region",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code, for example `us-gov-west-1`",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: regionInfoResultAttrTypes,
+		},
+	}
+}
+
+func (f regionInfoFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("Region (%s) is not in any known partition", region)))
+		return
+	}
+
+	// service_principal_suffix is the partition's default, used by most services.
+	// A few services in the China and ISO partitions use the partition's DNS suffix instead.
+	value := map[string]attr.Value{
+		"partition":                types.StringValue(partition.ID()),
+		"dns_suffix":               types.StringValue(partition.DNSSuffix()),
+		"dualstack_dns_suffix":     types.StringValue(names.DualStackDNSSuffixForPartition(partition)),
+		"service_principal_suffix": types.StringValue(names.ServicePrincipalSuffixForPartition("", partition)),
+		"opt_in":                   types.BoolValue(names.RegionIsOptIn(region)),
+	}
+
+	result, d := types.ObjectValue(regionInfoResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestRegionInfoFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testRegionInfoFunctionConfig("us-west-2"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testRegionInfoFunctionResult("aws", "amazonaws.com", "api.aws", false)),
+				},
+			},
+			{
+				Config: testRegionInfoFunctionConfig("af-south-1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testRegionInfoFunctionResult("aws", "amazonaws.com", "api.aws", true)),
+				},
+			},
+			{
+				Config: testRegionInfoFunctionConfig("us-gov-west-1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testRegionInfoFunctionResult("aws-us-gov", "amazonaws.com", "api.aws", false)),
+				},
+			},
+			{
+				Config: testRegionInfoFunctionConfig("cn-north-1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testRegionInfoFunctionResult("aws-cn", "amazonaws.com.cn", "api.amazonwebservices.com.cn", false)),
+				},
+			},
+			{
+				Config: testRegionInfoFunctionConfig("us-iso-east-1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testRegionInfoFunctionResult("aws-iso", "c2s.ic.gov", "api.aws.ic.gov", false)),
+				},
+			},
+		},
+	})
+}
+
+func TestRegionInfoFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testRegionInfoFunctionConfig("invalid"),
+				ExpectError: regexache.MustCompile(`is[\s\n]*not[\s\n]*in[\s\n]*any[\s\n]*known[\s\n]*partition`),
+			},
+		},
+	})
+}
+
+func testRegionInfoFunctionResult(partition, dnsSuffix, dualStackDNSSuffix string, optIn bool) knownvalue.Check {
+	return knownvalue.ObjectExact(map[string]knownvalue.Check{
+		"partition":                knownvalue.StringExact(partition),
+		"dns_suffix":               knownvalue.StringExact(dnsSuffix),
+		"dualstack_dns_suffix":     knownvalue.StringExact(dualStackDNSSuffix),
+		"service_principal_suffix": knownvalue.StringExact("amazonaws.com"),
+		"opt_in":                   knownvalue.Bool(optIn),
+	})
+}
+
+func testRegionInfoFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::region_info(%[1]q)
+}
+`, arg)
+}
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const (
+	s3URIFormatPath          = "path"
+	s3URIFormatS3            = "s3"
+	s3URIFormatVirtualHosted = "virtual_hosted"
+
+	s3URIOptionDualstack = "dualstack"
+	s3URIOptionFIPS      = "fips"
+)
+
+var _ function.Function = s3URIBuildFunction{}
+
+func NewS3URIBuildFunction() function.Function {
+	return &s3URIBuildFunction{}
+}
+
+type s3URIBuildFunction struct{}
+
+func (f s3URIBuildFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_build"
+}
+
+func (f s3URIBuildFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "s3_uri_build Function",
+		MarkdownDescription: "Builds an S3 URI or object URL from a bucket or access point and an object key",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "format",
+				MarkdownDescription: "Format of the result. Valid values are `s3`, `virtual_hosted` and `path`",
+			},
+			function.StringParameter{
+				Name:                "bucket",
+				MarkdownDescription: "Bucket name or access point ARN",
+			},
+			function.StringParameter{
+				Name:                "key",
+				MarkdownDescription: "Object key. May be empty",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code. May be empty to use the global endpoint for general purpose buckets",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "options",
+			MarkdownDescription: "Endpoint options. Valid values are `dualstack` and `fips`",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f s3URIBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var format, bucket, key, region string
+	var options []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &format, &bucket, &key, &region, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	parts := &s3URI{
+		key:    key,
+		region: region,
+	}
+
+	if arn.IsARN(bucket) {
+		ap, err := parseS3URIARN(bucket, "")
+		if err != nil || ap.accessPoint == "" || ap.key != "" {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "bucket must be a bucket name or access point ARN"))
+			return
+		}
+		if region != "" && region != ap.region {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(3, fmt.Sprintf("region (%s) does not match access point Region (%s)", region, ap.region)))
+			return
+		}
+		parts.accessPoint = ap.accessPoint
+		parts.region = ap.region
+	} else {
+		parts.bucket = bucket
+		parts.bucketType = s3BucketTypeFor(bucket)
+	}
+
+	for _, option := range options {
+		switch option {
+		case s3URIOptionDualstack:
+			parts.dualstack = true
+		case s3URIOptionFIPS:
+			parts.fips = true
+		default:
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(4, fmt.Sprintf("unsupported option (%s)", option)))
+			return
+		}
+	}
+
+	result, err := buildS3URI(format, parts)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func buildS3URI(format string, parts *s3URI) (string, error) {
+	if parts.bucket == "" && parts.accessPoint == "" {
+		return "", errors.New("bucket must be specified")
+	}
+
+	switch format {
+	case s3URIFormatS3:
+		bucket := parts.bucket
+		if parts.accessPoint != "" {
+			bucket = parts.accessPoint
+		}
+		if parts.key == "" {
+			return "s3://" + bucket, nil
+		}
+		return "s3://" + bucket + "/" + parts.key, nil
+	case s3URIFormatVirtualHosted, s3URIFormatPath:
+	default:
+		return "", fmt.Errorf("unsupported format (%s)", format)
+	}
+
+	if (parts.dualstack || parts.fips) && parts.region == "" {
+		return "", errors.New("region must be specified for dual-stack and FIPS endpoints")
+	}
+
+	service := "s3"
+	if parts.fips {
+		service = "s3-fips"
+	}
+
+	var host, path string
+	switch {
+	case parts.accessPoint != "":
+		if format == s3URIFormatPath {
+			return "", errors.New("path-style URLs are not supported for access points")
+		}
+
+		ap, err := arn.Parse(parts.accessPoint)
+		if err != nil {
+			return "", err
+		}
+
+		service = "s3-accesspoint"
+		if parts.fips {
+			service = "s3-accesspoint-fips"
+		}
+		host = fmt.Sprintf("%s-%s.%s", strings.TrimPrefix(ap.Resource, "accesspoint/"), ap.AccountID, s3Hostname(service, parts.region, parts.dualstack))
+		path = parts.key
+	case parts.bucketType == s3BucketTypeDirectory:
+		if format == s3URIFormatPath {
+			return "", errors.New("path-style URLs are not supported for directory buckets")
+		}
+		if parts.dualstack || parts.fips {
+			return "", errors.New("dual-stack and FIPS endpoints are not supported for directory buckets")
+		}
+		if parts.region == "" {
+			return "", errors.New("region must be specified for directory buckets")
+		}
+
+		zoneID := s3DirectoryBucketNameRegex.FindStringSubmatch(parts.bucket)[1]
+		host = parts.bucket + "." + s3Hostname("s3express-"+zoneID, parts.region, false)
+		path = parts.key
+	case format == s3URIFormatVirtualHosted:
+		host = parts.bucket + "." + s3Hostname(service, parts.region, parts.dualstack)
+		path = parts.key
+	default:
+		host = s3Hostname(service, parts.region, parts.dualstack)
+		path = parts.bucket
+		if parts.key != "" {
+			path += "/" + parts.key
+		}
+	}
+
+	u := url.URL{
+		Scheme: "https",
+		Host:   host,
+		Path:   "/" + path,
+	}
+
+	return u.String(), nil
+}
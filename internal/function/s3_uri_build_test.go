@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestS3URIBuildFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIBuildFunctionConfig("s3", "example", "path/to/key.txt", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "s3://example/path/to/key.txt"),
+				),
+			},
+			{
+				Config: testS3URIBuildFunctionConfig("virtual_hosted", "example", "path/to/key name.txt", "us-west-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://example.s3.us-west-2.amazonaws.com/path/to/key%20name.txt"),
+				),
+			},
+			{
+				Config: testS3URIBuildFunctionConfig("virtual_hosted", "example", "key", "us-west-2", "dualstack", "fips"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://example.s3-fips.dualstack.us-west-2.amazonaws.com/key"),
+				),
+			},
+			{
+				Config: testS3URIBuildFunctionConfig("path", "example", "key", "cn-north-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://s3.cn-north-1.amazonaws.com.cn/example/key"),
+				),
+			},
+			{
+				Config: testS3URIBuildFunctionConfig("virtual_hosted", "arn:aws:s3:us-west-2:444455556666:accesspoint/example-ap", "key", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://example-ap-444455556666.s3-accesspoint.us-west-2.amazonaws.com/key"),
+				),
+			},
+			{
+				Config: testS3URIBuildFunctionConfig("virtual_hosted", "example--usw2-az1--x-s3", "key", "us-west-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://example--usw2-az1--x-s3.s3express-usw2-az1.us-west-2.amazonaws.com/key"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIBuildFunctionConfig("ftp", "example", "key", ""),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*format`),
+			},
+			{
+				Config:      testS3URIBuildFunctionConfig("virtual_hosted", "example", "key", "us-west-2", "accelerate"),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*option`),
+			},
+			{
+				Config:      testS3URIBuildFunctionConfig("path", "example--usw2-az1--x-s3", "key", "us-west-2"),
+				ExpectError: regexache.MustCompile(`path-style[\s\n]*URLs[\s\n]*are[\s\n]*not[\s\n]*supported`),
+			},
+		},
+	})
+}
+
+func testS3URIBuildFunctionConfig(format, bucket, key, region string, options ...string) string {
+	args := []string{fmt.Sprintf("%q", format), fmt.Sprintf("%q", bucket), fmt.Sprintf("%q", key), fmt.Sprintf("%q", region)}
+	for _, v := range options {
+		args = append(args, fmt.Sprintf("%q", v))
+	}
+
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::s3_uri_build(%[1]s)
+}
+`, strings.Join(args, ", "))
+}
@@ -0,0 +1,372 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	s3BucketTypeDirectory      = "directory"
+	s3BucketTypeGeneralPurpose = "general_purpose"
+)
+
+var (
+	s3DirectoryBucketNameRegex = regexache.MustCompile(`^[0-9a-z.-]+--([0-9a-z]+(?:-[0-9a-z]+)+)--x-s3$`)
+	s3AccessPointHostRegex     = regexache.MustCompile(`^([0-9a-z-]+)-([0-9]{12})$`)
+)
+
+var s3URIParseResultAttrTypes = map[string]attr.Type{
+	"bucket":       types.StringType,
+	"key":          types.StringType,
+	"region":       types.StringType,
+	"access_point": types.StringType,
+	"bucket_type":  types.StringType,
+	"dualstack":    types.BoolType,
+	"fips":         types.BoolType,
+}
+
+var _ function.Function = s3URIParseFunction{}
+
+func NewS3URIParseFunction() function.Function {
+	return &s3URIParseFunction{}
+}
+
+type s3URIParseFunction struct{}
+
+func (f s3URIParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_parse"
+}
+
+func (f s3URIParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "s3_uri_parse Function",
+		MarkdownDescription: "Parses an S3 URI, virtual-hosted-style or path-style object URL, S3 ARN or bucket name " +
+			"into its constituent parts",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: "S3 URI, object URL, ARN or bucket name to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: s3URIParseResultAttrTypes,
+		},
+	}
+}
+
+func (f s3URIParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	parts, err := parseS3URI(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"bucket":       types.StringValue(parts.bucket),
+		"key":          types.StringValue(parts.key),
+		"region":       types.StringValue(parts.region),
+		"access_point": types.StringValue(parts.accessPoint),
+		"bucket_type":  types.StringValue(parts.bucketType),
+		"dualstack":    types.BoolValue(parts.dualstack),
+		"fips":         types.BoolValue(parts.fips),
+	}
+
+	result, d := types.ObjectValue(s3URIParseResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// s3URI holds the constituent parts of an S3 URI.
+// For access points, bucket is empty and accessPoint is the access point ARN.
+type s3URI struct {
+	bucket      string
+	key         string
+	region      string
+	accessPoint string
+	bucketType  string
+	dualstack   bool
+	fips        bool
+}
+
+func parseS3URI(s string) (*s3URI, error) {
+	var parts *s3URI
+	var err error
+
+	switch {
+	case strings.HasPrefix(s, "s3://"):
+		parts, err = parseS3URIScheme(strings.TrimPrefix(s, "s3://"))
+	case strings.HasPrefix(s, "https://"), strings.HasPrefix(s, "http://"):
+		parts, err = parseS3URIURL(s)
+	case arn.IsARN(s):
+		parts, err = parseS3URIARN(s, "object/")
+	default:
+		parts, err = parseS3URIBucketAndKey(s)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if parts.bucket != "" && parts.bucketType == "" {
+		parts.bucketType = s3BucketTypeFor(parts.bucket)
+	}
+
+	return parts, nil
+}
+
+func s3BucketTypeFor(bucket string) string {
+	if s3DirectoryBucketNameRegex.MatchString(bucket) {
+		return s3BucketTypeDirectory
+	}
+
+	return s3BucketTypeGeneralPurpose
+}
+
+// parseS3URIScheme parses the part of an s3:// URI following the scheme.
+// Access point ARNs are supported in the form used by the AWS CLI,
+// s3://arn:aws:s3:us-west-2:123456789012:accesspoint/example/key.
+func parseS3URIScheme(s string) (*s3URI, error) {
+	if arn.IsARN(s) {
+		return parseS3URIARN(s, "")
+	}
+
+	return parseS3URIBucketAndKey(s)
+}
+
+func parseS3URIBucketAndKey(s string) (*s3URI, error) {
+	bucket, key, _ := strings.Cut(s, "/")
+	if bucket == "" {
+		return nil, errors.New("bucket must be specified")
+	}
+
+	return &s3URI{
+		bucket: bucket,
+		key:    key,
+	}, nil
+}
+
+// parseS3URIARN parses a bucket or access point ARN, optionally followed by an object key.
+// keyPrefix is the separator between an access point name and the object key.
+func parseS3URIARN(s, keyPrefix string) (*s3URI, error) {
+	parsed, err := arn.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	switch parsed.Service {
+	case "s3":
+		if parsed.Region == "" && parsed.AccountID == "" {
+			// arn:aws:s3:::bucket/key
+			return parseS3URIBucketAndKey(parsed.Resource)
+		}
+
+		// arn:aws:s3:us-west-2:123456789012:accesspoint/example/object/key
+		name, ok := strings.CutPrefix(parsed.Resource, "accesspoint/")
+		if !ok {
+			return nil, fmt.Errorf("unsupported S3 ARN resource (%s)", parsed.Resource)
+		}
+		name, key, _ := strings.Cut(name, "/")
+		if name == "" {
+			return nil, errors.New("access point name must be specified")
+		}
+		if key != "" {
+			var ok bool
+			if key, ok = strings.CutPrefix(key, keyPrefix); !ok {
+				return nil, fmt.Errorf("object key must be prefixed with %q", keyPrefix)
+			}
+		}
+		parsed.Resource = "accesspoint/" + name
+
+		return &s3URI{
+			key:         key,
+			region:      parsed.Region,
+			accessPoint: parsed.String(),
+		}, nil
+	case "s3express":
+		// arn:aws:s3express:us-west-2:123456789012:bucket/example--usw2-az1--x-s3
+		bucket, ok := strings.CutPrefix(parsed.Resource, "bucket/")
+		if !ok {
+			return nil, fmt.Errorf("unsupported S3 Express ARN resource (%s)", parsed.Resource)
+		}
+		parts, err := parseS3URIBucketAndKey(bucket)
+		if err != nil {
+			return nil, err
+		}
+		parts.region = parsed.Region
+
+		return parts, nil
+	default:
+		return nil, fmt.Errorf("unsupported ARN service (%s)", parsed.Service)
+	}
+}
+
+// parseS3URIURL parses a virtual-hosted-style or path-style S3 object URL.
+func parseS3URIURL(s string) (*s3URI, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	host := strings.ToLower(u.Hostname())
+	partitions, labels, ok := splitS3Hostname(host)
+	if !ok {
+		return nil, fmt.Errorf("host (%s) is not an S3 endpoint", host)
+	}
+
+	parts := &s3URI{}
+	partition := partitions[0]
+
+	// Labels are processed from the right: [region], [dualstack], service.
+	if n := len(labels); n > 0 {
+		if v, ok := endpoints.PartitionForRegion(partitions, labels[n-1]); ok {
+			partition = v
+			parts.region = labels[n-1]
+			labels = labels[:n-1]
+		}
+	}
+	if n := len(labels); n > 0 && labels[n-1] == "dualstack" {
+		parts.dualstack = true
+		labels = labels[:n-1]
+	}
+	if len(labels) == 0 {
+		return nil, fmt.Errorf("host (%s) is not an S3 endpoint", host)
+	}
+
+	service := labels[len(labels)-1]
+	bucket := strings.Join(labels[:len(labels)-1], ".")
+	key := strings.TrimPrefix(u.Path, "/")
+
+	var accessPoint, directory bool
+	switch {
+	case service == "s3":
+	case service == "s3-fips":
+		parts.fips = true
+	case service == "s3-accesspoint":
+		accessPoint = true
+	case service == "s3-accesspoint-fips":
+		accessPoint = true
+		parts.fips = true
+	case service == "s3-external-1" && parts.region == "":
+		parts.region = endpoints.UsEast1RegionID
+	case strings.HasPrefix(service, "s3express-"):
+		directory = true
+	case strings.HasPrefix(service, "s3-") && parts.region == "":
+		// Legacy dash-Region endpoints, e.g. s3-us-west-2.amazonaws.com.
+		region := strings.TrimPrefix(service, "s3-")
+		v, ok := endpoints.PartitionForRegion(partitions, region)
+		if !ok {
+			return nil, fmt.Errorf("host (%s) is not an S3 endpoint", host)
+		}
+		partition = v
+		parts.region = region
+	default:
+		return nil, fmt.Errorf("host (%s) is not an S3 endpoint", host)
+	}
+
+	switch {
+	case accessPoint:
+		m := s3AccessPointHostRegex.FindStringSubmatch(bucket)
+		if m == nil || parts.region == "" {
+			return nil, fmt.Errorf("host (%s) is not an S3 access point endpoint", host)
+		}
+		parts.accessPoint = arn.ARN{
+			Partition: partition.ID(),
+			Service:   "s3",
+			Region:    parts.region,
+			AccountID: m[2],
+			Resource:  "accesspoint/" + m[1],
+		}.String()
+		parts.key = key
+	case bucket != "":
+		parts.bucket = bucket
+		parts.key = key
+	case directory:
+		return nil, fmt.Errorf("path-style URLs are not supported for directory buckets")
+	default:
+		bucket, key, _ := strings.Cut(key, "/")
+		if bucket == "" {
+			return nil, errors.New("bucket must be specified")
+		}
+		parts.bucket = bucket
+		parts.key = key
+	}
+
+	if directory {
+		parts.bucketType = s3BucketTypeDirectory
+	}
+
+	return parts, nil
+}
+
+// splitS3Hostname returns the partitions whose DNS suffix the hostname ends with,
+// and the dot-separated labels of the hostname preceding the DNS suffix.
+// Multiple partitions may share a DNS suffix.
+func splitS3Hostname(host string) ([]endpoints.Partition, []string, bool) {
+	var dnsSuffix string
+	var partitions []endpoints.Partition
+
+	for _, partition := range endpoints.DefaultPartitions() {
+		v := partition.DNSSuffix()
+		if !strings.HasSuffix(host, "."+v) {
+			continue
+		}
+
+		// Match the longest DNS suffix, e.g. amazonaws.com.cn rather than amazonaws.com.
+		switch {
+		case len(v) > len(dnsSuffix):
+			dnsSuffix = v
+			partitions = []endpoints.Partition{partition}
+		case v == dnsSuffix:
+			partitions = append(partitions, partition)
+		}
+	}
+
+	if dnsSuffix == "" {
+		return nil, nil, false
+	}
+
+	return partitions, strings.Split(strings.TrimSuffix(host, "."+dnsSuffix), "."), true
+}
+
+// s3Hostname returns the hostname of the S3 endpoint with the specified service
+// label in the partition for region. An empty region returns the global endpoint.
+func s3Hostname(service, region string, dualstack bool) string {
+	labels := []string{service}
+	if dualstack {
+		labels = append(labels, "dualstack")
+	}
+
+	dnsSuffix := names.PartitionForRegion(region).DNSSuffix()
+	if region == "" {
+		dnsSuffix = names.PartitionForRegion(endpoints.UsEast1RegionID).DNSSuffix()
+	} else {
+		labels = append(labels, region)
+	}
+
+	return strings.Join(append(labels, dnsSuffix), ".")
+}
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestS3URIParseFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("s3://example/path/to/key.txt"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testS3URIParseFunctionResult("example", "path/to/key.txt", "", "", "general_purpose", false, false)),
+				},
+			},
+			{
+				Config: testS3URIParseFunctionConfig("https://example.s3.us-west-2.amazonaws.com/path/to/key%20name.txt"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testS3URIParseFunctionResult("example", "path/to/key name.txt", "us-west-2", "", "general_purpose", false, false)),
+				},
+			},
+			{
+				Config: testS3URIParseFunctionConfig("https://s3-fips.dualstack.us-gov-west-1.amazonaws.com/example/key"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testS3URIParseFunctionResult("example", "key", "us-gov-west-1", "", "general_purpose", true, true)),
+				},
+			},
+			{
+				Config: testS3URIParseFunctionConfig("https://example.s3.cn-north-1.amazonaws.com.cn/key"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testS3URIParseFunctionResult("example", "key", "cn-north-1", "", "general_purpose", false, false)),
+				},
+			},
+			{
+				Config: testS3URIParseFunctionConfig("https://example-ap-444455556666.s3-accesspoint.dualstack.us-west-2.amazonaws.com/key"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testS3URIParseFunctionResult("", "key", "us-west-2", "arn:aws:s3:us-west-2:444455556666:accesspoint/example-ap", "", true, false)),
+				},
+			},
+			{
+				Config: testS3URIParseFunctionConfig("arn:aws:s3:us-west-2:444455556666:accesspoint/example-ap/object/key"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testS3URIParseFunctionResult("", "key", "us-west-2", "arn:aws:s3:us-west-2:444455556666:accesspoint/example-ap", "", false, false)),
+				},
+			},
+			{
+				Config: testS3URIParseFunctionConfig("https://example--usw2-az1--x-s3.s3express-usw2-az1.us-west-2.amazonaws.com/key"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testS3URIParseFunctionResult("example--usw2-az1--x-s3", "key", "us-west-2", "", "directory", false, false)),
+				},
+			},
+			{
+				Config: testS3URIParseFunctionConfig("arn:aws:s3:::example/key"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testS3URIParseFunctionResult("example", "key", "", "", "general_purpose", false, false)),
+				},
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIParseFunctionConfig("https://example.com/key"),
+				ExpectError: regexache.MustCompile(`is[\s\n]*not[\s\n]*an[\s\n]*S3[\s\n]*endpoint`),
+			},
+			{
+				Config:      testS3URIParseFunctionConfig("s3:///key"),
+				ExpectError: regexache.MustCompile(`bucket[\s\n]*must[\s\n]*be[\s\n]*specified`),
+			},
+			{
+				Config:      testS3URIParseFunctionConfig("arn:aws:sqs:us-west-2:444455556666:example"),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*ARN[\s\n]*service`),
+			},
+		},
+	})
+}
+
+func testS3URIParseFunctionResult(bucket, key, region, accessPoint, bucketType string, dualstack, fips bool) knownvalue.Check {
+	return knownvalue.ObjectExact(map[string]knownvalue.Check{
+		"bucket":       knownvalue.StringExact(bucket),
+		"key":          knownvalue.StringExact(key),
+		"region":       knownvalue.StringExact(region),
+		"access_point": knownvalue.StringExact(accessPoint),
+		"bucket_type":  knownvalue.StringExact(bucketType),
+		"dualstack":    knownvalue.Bool(dualstack),
+		"fips":         knownvalue.Bool(fips),
+	})
+}
+
+func testS3URIParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::s3_uri_parse(%[1]q)
+}
+`, arg)
+}
@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"time"
+	_ "time/tzdata" // Embed the IANA time zone database for platforms without one.
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// scheduleExpressionNextMaxCount is the maximum number of fire times returned by schedule_expression_next.
+const scheduleExpressionNextMaxCount = 1000
+
+var _ function.Function = scheduleExpressionNextFunction{}
+
+func NewScheduleExpressionNextFunction() function.Function {
+	return &scheduleExpressionNextFunction{}
+}
+
+type scheduleExpressionNextFunction struct{}
+
+func (f scheduleExpressionNextFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_expression_next"
+}
+
+func (f scheduleExpressionNextFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "schedule_expression_next Function",
+		MarkdownDescription: "Returns the next fire times of an `at()`, `cron()` or `rate()` schedule expression, as RFC3339 timestamps",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression",
+			},
+			function.StringParameter{
+				Name:                "timezone",
+				MarkdownDescription: "IANA time zone in which the expression is evaluated, for example `UTC` or `America/New_York`",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: "Maximum number of fire times to return",
+			},
+			function.StringParameter{
+				Name:                "start",
+				MarkdownDescription: "RFC3339 timestamp after which to return fire times, for example `plantimestamp()`",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f scheduleExpressionNextFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression, timezone, start string
+	var count int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression, &timezone, &count, &start))
+	if resp.Error != nil {
+		return
+	}
+
+	expr, err := parseScheduleExpression(expression)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("unsupported time zone (%s)", timezone)))
+		return
+	}
+
+	if count < 1 || count > scheduleExpressionNextMaxCount {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, fmt.Sprintf("count must be between 1 and %d", scheduleExpressionNextMaxCount)))
+		return
+	}
+
+	after, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(3, fmt.Sprintf("start (%s) must be an RFC3339 timestamp", start)))
+		return
+	}
+
+	times := expr.next(after.In(loc), int(count))
+	result := make([]string, len(times))
+	for i, t := range times {
+		result[i] = t.Format(time.RFC3339)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// next returns up to count fire times after the specified time, in the specified time's location.
+// Fire times of rate expressions are measured from the specified time.
+func (expr *scheduleExpression) next(after time.Time, count int) []time.Time {
+	var times []time.Time
+
+	switch expr.typ {
+	case scheduleExpressionTypeAt:
+		at := time.Date(expr.at.Year(), expr.at.Month(), expr.at.Day(), expr.at.Hour(), expr.at.Minute(), expr.at.Second(), 0, after.Location())
+		if at.After(after) {
+			times = append(times, at)
+		}
+	case scheduleExpressionTypeCron:
+		times = expr.cron.next(after, count)
+	case scheduleExpressionTypeRate:
+		for i := 1; i <= count; i++ {
+			times = append(times, after.Add(time.Duration(i)*expr.rate))
+		}
+	}
+
+	return times
+}
+
+// next returns up to count fire times after the specified time, in the specified time's location.
+// Local times which do not exist because of daylight saving time transitions are skipped.
+func (cron *cronExpression) next(after time.Time, count int) []time.Time {
+	var times []time.Time
+
+	loc := after.Location()
+	y, m, d := after.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	for day.Year() <= cronYearSpec.max {
+		y, m, d := day.Date()
+
+		switch {
+		case !cron.years[y]:
+			day = time.Date(y+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		case !cron.months[m]:
+			day = time.Date(y, m+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		case cron.matchDay(day):
+			for hour, ok := range cron.hours {
+				if !ok {
+					continue
+				}
+				for minute, ok := range cron.minutes {
+					if !ok {
+						continue
+					}
+
+					t := time.Date(y, m, d, hour, minute, 0, 0, loc)
+					if t.Day() != d || t.Hour() != hour || t.Minute() != minute {
+						continue
+					}
+					if !t.After(after) {
+						continue
+					}
+
+					times = append(times, t)
+					if len(times) == count {
+						return times
+					}
+				}
+			}
+		}
+
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return times
+}
+
+// matchDay returns whether the day-of-month and day-of-week fields match the specified UTC date.
+func (cron *cronExpression) matchDay(day time.Time) bool {
+	y, m, d := day.Date()
+	lastDay := cronDaysIn(y, m)
+	weekday := int(day.Weekday()) + 1
+
+	switch {
+	case cron.anyDay:
+	case cron.lastDay:
+		if d != lastDay {
+			return false
+		}
+	case cron.lastWeekday:
+		if d != cronNearestWeekday(y, m, lastDay) {
+			return false
+		}
+	case cron.nearestWeekday != 0:
+		if cron.nearestWeekday > lastDay || d != cronNearestWeekday(y, m, cron.nearestWeekday) {
+			return false
+		}
+	default:
+		if !cron.days[d] {
+			return false
+		}
+	}
+
+	switch {
+	case cron.anyWeekday:
+	case cron.lastOfMonth != 0:
+		if weekday != cron.lastOfMonth || d+7 <= lastDay {
+			return false
+		}
+	case cron.nthOfMonth != 0:
+		if weekday != cron.nthOfMonth || (d-1)/7+1 != cron.nth {
+			return false
+		}
+	default:
+		if !cron.weekdays[weekday] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// cronDaysIn returns the number of days in the specified month.
+func cronDaysIn(y int, m time.Month) int {
+	return time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// cronNearestWeekday returns the weekday (Monday to Friday) nearest the specified day, without crossing into another month.
+func cronNearestWeekday(y int, m time.Month, d int) int {
+	switch time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if d == 1 {
+			return d + 2
+		}
+		return d - 1
+	case time.Sunday:
+		if d == cronDaysIn(y, m) {
+			return d - 2
+		}
+		return d + 1
+	default:
+		return d
+	}
+}
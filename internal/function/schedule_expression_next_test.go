@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestScheduleExpressionNextFunction_known(t *testing.T) {
+	t.Parallel()
+
+	const start = "2026-10-16T00:00:00Z"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionNextFunctionConfig("cron(0 10 ? * MON-FRI *)", "UTC", 3, start),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testScheduleExpressionNextFunctionResult("2026-10-16T10:00:00Z", "2026-10-19T10:00:00Z", "2026-10-20T10:00:00Z")),
+				},
+			},
+			{
+				Config: testScheduleExpressionNextFunctionConfig("cron(0 0 L * ? *)", "UTC", 3, start),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testScheduleExpressionNextFunctionResult("2026-10-31T00:00:00Z", "2026-11-30T00:00:00Z", "2026-12-31T00:00:00Z")),
+				},
+			},
+			{
+				Config: testScheduleExpressionNextFunctionConfig("cron(0 9 1W * ? *)", "UTC", 2, start),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testScheduleExpressionNextFunctionResult("2026-11-02T09:00:00Z", "2026-12-01T09:00:00Z")),
+				},
+			},
+			{
+				Config: testScheduleExpressionNextFunctionConfig("cron(0 9 ? * 6#3 *)", "UTC", 2, start),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testScheduleExpressionNextFunctionResult("2026-10-16T09:00:00Z", "2026-11-20T09:00:00Z")),
+				},
+			},
+			{
+				Config: testScheduleExpressionNextFunctionConfig("cron(0 9 ? * 2L *)", "UTC", 2, start),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testScheduleExpressionNextFunctionResult("2026-10-26T09:00:00Z", "2026-11-30T09:00:00Z")),
+				},
+			},
+			{
+				Config: testScheduleExpressionNextFunctionConfig("cron(30 2 * * ? *)", "America/New_York", 3, "2026-03-07T00:00:00Z"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testScheduleExpressionNextFunctionResult("2026-03-07T02:30:00-05:00", "2026-03-09T02:30:00-04:00", "2026-03-10T02:30:00-04:00")),
+				},
+			},
+			{
+				Config: testScheduleExpressionNextFunctionConfig("cron(0 0 1 1 ? 2020)", "UTC", 3, start),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testScheduleExpressionNextFunctionResult()),
+				},
+			},
+			{
+				Config: testScheduleExpressionNextFunctionConfig("rate(5 minutes)", "UTC", 2, start),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testScheduleExpressionNextFunctionResult("2026-10-16T00:05:00Z", "2026-10-16T00:10:00Z")),
+				},
+			},
+			{
+				Config: testScheduleExpressionNextFunctionConfig("at(2026-11-20T13:00:00)", "America/New_York", 3, start),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testScheduleExpressionNextFunctionResult("2026-11-20T13:00:00-05:00")),
+				},
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	const start = "2026-10-16T00:00:00Z"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleExpressionNextFunctionConfig("cron(0 12 * * * *)", "UTC", 1, start),
+				ExpectError: regexache.MustCompile(`exactly[\s\n]*one[\s\n]*of[\s\n]*the[\s\n]*day-of-month[\s\n]*and[\s\n]*day-of-week`),
+			},
+			{
+				Config:      testScheduleExpressionNextFunctionConfig("rate(1 day)", "Mars/Olympus_Mons", 1, start),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*time[\s\n]*zone`),
+			},
+			{
+				Config:      testScheduleExpressionNextFunctionConfig("rate(1 day)", "UTC", 0, start),
+				ExpectError: regexache.MustCompile(`count[\s\n]*must[\s\n]*be[\s\n]*between`),
+			},
+			{
+				Config:      testScheduleExpressionNextFunctionConfig("rate(1 day)", "UTC", 1, "2026-10-16"),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*an[\s\n]*RFC3339[\s\n]*timestamp`),
+			},
+		},
+	})
+}
+
+func testScheduleExpressionNextFunctionConfig(expression, timezone string, count int, start string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::schedule_expression_next(%[1]q, %[2]q, %[3]d, %[4]q)
+}
+`, expression, timezone, count, start)
+}
+
+func testScheduleExpressionNextFunctionResult(times ...string) knownvalue.Check {
+	checks := make([]knownvalue.Check, len(times))
+	for i, v := range times {
+		checks[i] = knownvalue.StringExact(v)
+	}
+
+	return knownvalue.ListExact(checks)
+}
@@ -0,0 +1,453 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	scheduleExpressionTypeAt   = "at"
+	scheduleExpressionTypeCron = "cron"
+	scheduleExpressionTypeRate = "rate"
+)
+
+const (
+	scheduleExpressionRateUnitDay    = "day"
+	scheduleExpressionRateUnitHour   = "hour"
+	scheduleExpressionRateUnitMinute = "minute"
+)
+
+// scheduleExpressionAtLayout is the layout of the timestamp in one-time schedule expressions.
+const scheduleExpressionAtLayout = "2006-01-02T15:04:05"
+
+// scheduleExpressionDialect describes the schedule expressions accepted by a service.
+type scheduleExpressionDialect struct {
+	service string
+	types   []string
+	// rateUnits are the rate expression units accepted by the service. Nil means all units.
+	rateUnits []string
+}
+
+// scheduleExpressionDialects are the services which accept schedule expressions, in alphabetical order.
+// See:
+//   - https://docs.aws.amazon.com/aws-backup/latest/devguide/creating-a-backup-plan.html
+//   - https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html
+//   - https://docs.aws.amazon.com/glue/latest/dg/monitor-data-warehouse-schedule.html
+//   - https://docs.aws.amazon.com/scheduler/latest/UserGuide/schedule-types.html
+//   - https://docs.aws.amazon.com/systems-manager/latest/userguide/reference-cron-and-rate-expressions.html
+var scheduleExpressionDialects = []scheduleExpressionDialect{
+	{
+		service: names.Backup,
+		types:   []string{scheduleExpressionTypeCron},
+	},
+	{
+		service: names.Events,
+		types:   []string{scheduleExpressionTypeCron, scheduleExpressionTypeRate},
+	},
+	{
+		service: names.Glue,
+		types:   []string{scheduleExpressionTypeCron},
+	},
+	{
+		service: names.Scheduler,
+		types:   []string{scheduleExpressionTypeAt, scheduleExpressionTypeCron, scheduleExpressionTypeRate},
+	},
+	{
+		service:   names.SSM,
+		types:     []string{scheduleExpressionTypeAt, scheduleExpressionTypeCron, scheduleExpressionTypeRate},
+		rateUnits: []string{scheduleExpressionRateUnitDay, scheduleExpressionRateUnitHour},
+	},
+}
+
+var scheduleExpressionValidateResultAttrTypes = map[string]attr.Type{
+	names.AttrType: types.StringType,
+	"services":     types.ListType{ElemType: types.StringType},
+}
+
+var _ function.Function = scheduleExpressionValidateFunction{}
+
+func NewScheduleExpressionValidateFunction() function.Function {
+	return &scheduleExpressionValidateFunction{}
+}
+
+type scheduleExpressionValidateFunction struct{}
+
+func (f scheduleExpressionValidateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_expression_validate"
+}
+
+func (f scheduleExpressionValidateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "schedule_expression_validate Function",
+		MarkdownDescription: "Validates an `at()`, `cron()` or `rate()` schedule expression " +
+			"and returns the expression type and the services which accept it",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression to validate",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: scheduleExpressionValidateResultAttrTypes,
+		},
+	}
+}
+
+func (f scheduleExpressionValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression))
+	if resp.Error != nil {
+		return
+	}
+
+	expr, err := parseScheduleExpression(expression)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	services, d := types.ListValueFrom(ctx, types.StringType, expr.services())
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	value := map[string]attr.Value{
+		names.AttrType: types.StringValue(expr.typ),
+		"services":     services,
+	}
+
+	result, d := types.ObjectValue(scheduleExpressionValidateResultAttrTypes, value)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// scheduleExpression is a parsed at(), cron() or rate() schedule expression.
+type scheduleExpression struct {
+	typ string
+	// at is the wall-clock time of a one-time schedule, in UTC.
+	at   time.Time
+	cron *cronExpression
+	// rate and rateUnit are the interval and unit of a rate-based schedule.
+	rate     time.Duration
+	rateUnit string
+}
+
+var scheduleExpressionRegex = regexache.MustCompile(`^(at|cron|rate)\((.*)\)$`)
+
+func parseScheduleExpression(s string) (*scheduleExpression, error) {
+	m := scheduleExpressionRegex.FindStringSubmatch(s)
+	if m == nil {
+		return nil, fmt.Errorf("unsupported expression (%s), must be of the form at(...), cron(...) or rate(...)", s)
+	}
+
+	expr := &scheduleExpression{
+		typ: m[1],
+	}
+
+	switch expr.typ {
+	case scheduleExpressionTypeAt:
+		at, err := time.Parse(scheduleExpressionAtLayout, m[2])
+		if err != nil {
+			return nil, fmt.Errorf("at expression (%s) must be of the form at(yyyy-mm-ddThh:mm:ss)", s)
+		}
+		expr.at = at
+	case scheduleExpressionTypeCron:
+		cron, err := parseCronExpression(m[2])
+		if err != nil {
+			return nil, fmt.Errorf("cron expression (%s): %w", s, err)
+		}
+		expr.cron = cron
+	case scheduleExpressionTypeRate:
+		rate, unit, err := parseRateExpression(m[2])
+		if err != nil {
+			return nil, fmt.Errorf("rate expression (%s): %w", s, err)
+		}
+		expr.rate, expr.rateUnit = rate, unit
+	}
+
+	return expr, nil
+}
+
+// services returns the services which accept the schedule expression.
+func (expr *scheduleExpression) services() []string {
+	services := make([]string, 0, len(scheduleExpressionDialects))
+
+	for _, dialect := range scheduleExpressionDialects {
+		if !slices.Contains(dialect.types, expr.typ) {
+			continue
+		}
+		if expr.typ == scheduleExpressionTypeRate && dialect.rateUnits != nil && !slices.Contains(dialect.rateUnits, expr.rateUnit) {
+			continue
+		}
+		services = append(services, dialect.service)
+	}
+
+	return services
+}
+
+func parseRateExpression(s string) (time.Duration, string, error) {
+	parts := strings.Fields(s)
+	if len(parts) != 2 {
+		return 0, "", errors.New("must be of the form rate(value unit)")
+	}
+
+	value, err := strconv.Atoi(parts[0])
+	if err != nil || value < 1 {
+		return 0, "", fmt.Errorf("value (%s) must be a positive integer", parts[0])
+	}
+
+	unit, plural := strings.CutSuffix(parts[1], "s")
+	switch {
+	case value == 1 && plural:
+		return 0, "", fmt.Errorf("unit (%s) must be singular when value is 1", parts[1])
+	case value != 1 && !plural:
+		return 0, "", fmt.Errorf("unit (%s) must be plural when value is greater than 1", parts[1])
+	}
+
+	var d time.Duration
+	switch unit {
+	case scheduleExpressionRateUnitDay:
+		d = 24 * time.Hour
+	case scheduleExpressionRateUnitHour:
+		d = time.Hour
+	case scheduleExpressionRateUnitMinute:
+		d = time.Minute
+	default:
+		return 0, "", fmt.Errorf("unsupported unit (%s), must be one of minute, minutes, hour, hours, day or days", parts[1])
+	}
+
+	return time.Duration(value) * d, unit, nil
+}
+
+// cronField is the set of values matched by a cron expression field, indexed by value.
+type cronField []bool
+
+// cronFieldSpec describes the values allowed in a cron expression field.
+type cronFieldSpec struct {
+	name     string
+	min, max int
+	// names are the alternative names of values, in upper case.
+	names map[string]int
+}
+
+var (
+	cronMinutesSpec = cronFieldSpec{name: "minutes", min: 0, max: 59}
+	cronHoursSpec   = cronFieldSpec{name: "hours", min: 0, max: 23}
+	cronDaySpec     = cronFieldSpec{name: "day-of-month", min: 1, max: 31}
+	cronMonthSpec   = cronFieldSpec{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6, "JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	cronWeekdaySpec = cronFieldSpec{name: "day-of-week", min: 1, max: 7, names: map[string]int{
+		"SUN": 1, "MON": 2, "TUE": 3, "WED": 4, "THU": 5, "FRI": 6, "SAT": 7,
+	}}
+	cronYearSpec = cronFieldSpec{name: "year", min: 1970, max: 2199}
+)
+
+// cronExpression is a parsed six-field AWS cron expression: minutes, hours, day-of-month, month, day-of-week and year.
+// Days of the week are numbered 1 (Sunday) to 7 (Saturday).
+type cronExpression struct {
+	minutes, hours, months, years cronField
+
+	// Day-of-month. Exactly one of the day-of-month and day-of-week fields is "?".
+	anyDay         bool
+	days           cronField
+	lastDay        bool // L
+	lastWeekday    bool // LW
+	nearestWeekday int  // nW, the weekday nearest day n
+
+	// Day-of-week.
+	anyWeekday  bool
+	weekdays    cronField
+	lastOfMonth int // nL, the last day n of the month
+	nthOfMonth  int // n#k, the k'th day n of the month
+	nth         int
+}
+
+var (
+	cronNearestWeekdayRegex = regexache.MustCompile(`^([0-9]+)W$`)
+	cronLastWeekdayRegex    = regexache.MustCompile(`^([0-9A-Za-z]+)L$`)
+	cronNthWeekdayRegex     = regexache.MustCompile(`^([0-9A-Za-z]+)#([0-9]+)$`)
+)
+
+func parseCronExpression(s string) (*cronExpression, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("must have 6 fields (minutes, hours, day-of-month, month, day-of-week and year), got %d", len(fields))
+	}
+
+	var cron cronExpression
+	var err error
+
+	if cron.minutes, err = parseCronField(cronMinutesSpec, fields[0]); err != nil {
+		return nil, err
+	}
+	if cron.hours, err = parseCronField(cronHoursSpec, fields[1]); err != nil {
+		return nil, err
+	}
+	if err = cron.parseDayOfMonth(fields[2]); err != nil {
+		return nil, err
+	}
+	if cron.months, err = parseCronField(cronMonthSpec, fields[3]); err != nil {
+		return nil, err
+	}
+	if err = cron.parseDayOfWeek(fields[4]); err != nil {
+		return nil, err
+	}
+	if cron.years, err = parseCronField(cronYearSpec, fields[5]); err != nil {
+		return nil, err
+	}
+
+	if cron.anyDay == cron.anyWeekday {
+		return nil, errors.New(`exactly one of the day-of-month and day-of-week fields must be "?"`)
+	}
+
+	return &cron, nil
+}
+
+func (cron *cronExpression) parseDayOfMonth(s string) error {
+	switch s {
+	case "?":
+		cron.anyDay = true
+		return nil
+	case "L":
+		cron.lastDay = true
+		return nil
+	case "LW":
+		cron.lastWeekday = true
+		return nil
+	}
+
+	if m := cronNearestWeekdayRegex.FindStringSubmatch(s); m != nil {
+		v, err := cronDaySpec.parseValue(m[1])
+		if err != nil {
+			return err
+		}
+		cron.nearestWeekday = v
+		return nil
+	}
+
+	var err error
+	cron.days, err = parseCronField(cronDaySpec, s)
+
+	return err
+}
+
+func (cron *cronExpression) parseDayOfWeek(s string) error {
+	switch s {
+	case "?":
+		cron.anyWeekday = true
+		return nil
+	case "L":
+		// L on its own is the last day of the week, Saturday.
+		s = "7"
+	}
+
+	if m := cronLastWeekdayRegex.FindStringSubmatch(s); m != nil {
+		v, err := cronWeekdaySpec.parseValue(m[1])
+		if err != nil {
+			return err
+		}
+		cron.lastOfMonth = v
+		return nil
+	}
+
+	if m := cronNthWeekdayRegex.FindStringSubmatch(s); m != nil {
+		v, err := cronWeekdaySpec.parseValue(m[1])
+		if err != nil {
+			return err
+		}
+		n, err := strconv.Atoi(m[2])
+		if err != nil || n < 1 || n > 5 {
+			return fmt.Errorf("day-of-week: occurrence (%s) must be between 1 and 5", m[2])
+		}
+		cron.nthOfMonth, cron.nth = v, n
+		return nil
+	}
+
+	var err error
+	cron.weekdays, err = parseCronField(cronWeekdaySpec, s)
+
+	return err
+}
+
+// parseCronField parses a comma-separated list of values, ranges (a-b), steps (a/n, a-b/n or */n) and wildcards (*).
+func parseCronField(spec cronFieldSpec, s string) (cronField, error) {
+	field := make(cronField, spec.max+1)
+
+	for item := range strings.SplitSeq(s, ",") {
+		r, step, hasStep := strings.Cut(item, "/")
+
+		n := 1
+		if hasStep {
+			var err error
+			n, err = strconv.Atoi(step)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("%s: increment (%s) must be a positive integer", spec.name, step)
+			}
+		}
+
+		var lo, hi int
+		if r == "*" {
+			lo, hi = spec.min, spec.max
+		} else {
+			from, to, isRange := strings.Cut(r, "-")
+
+			var err error
+			if lo, err = spec.parseValue(from); err != nil {
+				return nil, err
+			}
+
+			switch {
+			case isRange:
+				if hi, err = spec.parseValue(to); err != nil {
+					return nil, err
+				}
+				if lo > hi {
+					return nil, fmt.Errorf("%s: range (%s) start must not be after end", spec.name, r)
+				}
+			case hasStep:
+				hi = spec.max
+			default:
+				hi = lo
+			}
+		}
+
+		for v := lo; v <= hi; v += n {
+			field[v] = true
+		}
+	}
+
+	return field, nil
+}
+
+func (spec cronFieldSpec) parseValue(s string) (int, error) {
+	if v, ok := spec.names[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%s: unsupported value (%s)", spec.name, s)
+	}
+	if v < spec.min || v > spec.max {
+		return 0, fmt.Errorf("%s: value (%d) must be between %d and %d", spec.name, v, spec.min, spec.max)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestScheduleExpressionValidateFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionValidateFunctionConfig("cron(0 10 ? * MON-FRI *)"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testScheduleExpressionValidateFunctionResult("cron", "backup", "events", "glue", "scheduler", "ssm")),
+				},
+			},
+			{
+				Config: testScheduleExpressionValidateFunctionConfig("cron(0 9 LW * ? 2026-2030)"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testScheduleExpressionValidateFunctionResult("cron", "backup", "events", "glue", "scheduler", "ssm")),
+				},
+			},
+			{
+				Config: testScheduleExpressionValidateFunctionConfig("cron(0/15 * ? * 6#3 *)"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testScheduleExpressionValidateFunctionResult("cron", "backup", "events", "glue", "scheduler", "ssm")),
+				},
+			},
+			{
+				Config: testScheduleExpressionValidateFunctionConfig("rate(5 minutes)"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testScheduleExpressionValidateFunctionResult("rate", "events", "scheduler")),
+				},
+			},
+			{
+				Config: testScheduleExpressionValidateFunctionConfig("rate(1 day)"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testScheduleExpressionValidateFunctionResult("rate", "events", "scheduler", "ssm")),
+				},
+			},
+			{
+				Config: testScheduleExpressionValidateFunctionConfig("at(2026-11-20T13:00:00)"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", testScheduleExpressionValidateFunctionResult("at", "scheduler", "ssm")),
+				},
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionValidateFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleExpressionValidateFunctionConfig("every day"),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*expression`),
+			},
+			{
+				Config:      testScheduleExpressionValidateFunctionConfig("cron(0 12 * * ?)"),
+				ExpectError: regexache.MustCompile(`must[\s\n]*have[\s\n]*6[\s\n]*fields`),
+			},
+			{
+				Config:      testScheduleExpressionValidateFunctionConfig("cron(0 12 * * * *)"),
+				ExpectError: regexache.MustCompile(`exactly[\s\n]*one[\s\n]*of[\s\n]*the[\s\n]*day-of-month[\s\n]*and[\s\n]*day-of-week`),
+			},
+			{
+				Config:      testScheduleExpressionValidateFunctionConfig("cron(60 12 * * ? *)"),
+				ExpectError: regexache.MustCompile(`minutes:[\s\n]*value[\s\n]*\(60\)`),
+			},
+			{
+				Config:      testScheduleExpressionValidateFunctionConfig("cron(0 12 ? * 2#6 *)"),
+				ExpectError: regexache.MustCompile(`occurrence[\s\n]*\(6\)`),
+			},
+			{
+				Config:      testScheduleExpressionValidateFunctionConfig("rate(1 days)"),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*singular`),
+			},
+			{
+				Config:      testScheduleExpressionValidateFunctionConfig("rate(2 weeks)"),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*unit`),
+			},
+			{
+				Config:      testScheduleExpressionValidateFunctionConfig("at(2026-11-20)"),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*of[\s\n]*the[\s\n]*form[\s\n]*at\(yyyy-mm-ddThh:mm:ss\)`),
+			},
+		},
+	})
+}
+
+func testScheduleExpressionValidateFunctionConfig(expression string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::schedule_expression_validate(%[1]q)
+}
+`, expression)
+}
+
+func testScheduleExpressionValidateFunctionResult(typ string, services ...string) knownvalue.Check {
+	checks := make([]knownvalue.Check, len(services))
+	for i, service := range services {
+		checks[i] = knownvalue.StringExact(service)
+	}
+
+	return knownvalue.ObjectExact(map[string]knownvalue.Check{
+		names.AttrType: knownvalue.StringExact(typ),
+		"services":     knownvalue.ListExact(checks),
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ function.Function = tagsFilterAWSPrefixFunction{}
+
+func NewTagsFilterAWSPrefixFunction() function.Function { // nosemgrep:ci.aws-in-func-name
+	return &tagsFilterAWSPrefixFunction{}
+}
+
+type tagsFilterAWSPrefixFunction struct{}
+
+func (f tagsFilterAWSPrefixFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_filter_aws_prefix"
+}
+
+func (f tagsFilterAWSPrefixFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_filter_aws_prefix Function",
+		MarkdownDescription: "Removes tags with keys beginning with `aws:` from a map of tags, along with any " +
+			"system tags added by the specified services",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "tags",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Map of tags to filter",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name: "services",
+			MarkdownDescription: "Services whose system tags are also removed. Valid values are `elasticbeanstalk` " +
+				"and `serverlessrepo`",
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f tagsFilterAWSPrefixFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tags map[string]string
+	var services []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tags, &services))
+	if resp.Error != nil {
+		return
+	}
+
+	kvtags := tftags.New(ctx, tags).IgnoreAWS()
+	for _, service := range services {
+		switch service {
+		case names.ElasticBeanstalk, names.ServerlessRepo:
+			kvtags = kvtags.IgnoreSystem(service)
+		default:
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("unsupported service (%s)", service)))
+			return
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, kvtags.Map()))
+}
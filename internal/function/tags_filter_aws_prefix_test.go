@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestTagsFilterAWSPrefixFunction_known(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsFilterAWSPrefixFunctionConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.MapExact(map[string]knownvalue.Check{
+						"Name":                            knownvalue.StringExact("example"),
+						"elasticbeanstalk:environment-id": knownvalue.StringExact("e-example"),
+					})),
+				},
+			},
+			{
+				Config: testTagsFilterAWSPrefixFunctionConfig("elasticbeanstalk"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.MapExact(map[string]knownvalue.Check{})),
+				},
+			},
+		},
+	})
+}
+
+func TestTagsFilterAWSPrefixFunction_invalid(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testTagsFilterAWSPrefixFunctionConfig("invalid"),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*service`),
+			},
+		},
+	})
+}
+
+func testTagsFilterAWSPrefixFunctionConfig(services ...string) string { // nosemgrep:ci.aws-in-func-name
+	args := []string{`{ Name = "example", "aws:cloudformation:stack-name" = "example", "elasticbeanstalk:environment-id" = "e-example" }`}
+	for _, v := range services {
+		args = append(args, fmt.Sprintf("%q", v))
+	}
+
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::tags_filter_aws_prefix(%[1]s)
+}
+`, strings.Join(args, ", "))
+}
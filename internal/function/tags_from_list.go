@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var _ function.Function = tagsFromListFunction{}
+
+func NewTagsFromListFunction() function.Function {
+	return &tagsFromListFunction{}
+}
+
+type tagsFromListFunction struct{}
+
+func (f tagsFromListFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_from_list"
+}
+
+func (f tagsFromListFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_from_list Function",
+		MarkdownDescription: "Converts a list of tags to a map of tags. List elements may be objects with `Key` and `Value` " +
+			"or `key` and `value` attributes, or strings of the form `key=value`",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "tags",
+				MarkdownDescription: "List of tags to convert",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f tagsFromListFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tags types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tags))
+	if resp.Error != nil {
+		return
+	}
+
+	var elements []attr.Value
+	switch v := tags.UnderlyingValue().(type) {
+	case basetypes.ListValue:
+		elements = v.Elements()
+	case basetypes.SetValue:
+		elements = v.Elements()
+	case basetypes.TupleValue:
+		elements = v.Elements()
+	default:
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, "tags must be a list"))
+		return
+	}
+
+	m := make(map[string]*string, len(elements))
+	for i, element := range elements {
+		key, value, err := expandTagsListElement(element)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("element %d: %s", i, err)))
+			return
+		}
+
+		if _, ok := m[key]; ok {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("element %d: duplicate key (%s)", i, key)))
+			return
+		}
+		m[key] = value
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, tftags.New(ctx, m).Map()))
+}
+
+// expandTagsListElement returns the key and value of a list element in any of the
+// formats supported by tags_to_list.
+func expandTagsListElement(v attr.Value) (string, *string, error) {
+	if v.IsNull() {
+		return "", nil, errors.New("element must not be null")
+	}
+
+	var attrs map[string]attr.Value
+	switch v := v.(type) {
+	case basetypes.StringValue:
+		key, value, ok := strings.Cut(v.ValueString(), "=")
+		if !ok || key == "" {
+			return "", nil, fmt.Errorf("string element (%s) must be of the form key=value", v.ValueString())
+		}
+		return key, &value, nil
+	case basetypes.ObjectValue:
+		attrs = v.Attributes()
+	case basetypes.MapValue:
+		attrs = v.Elements()
+	default:
+		return "", nil, errors.New("element must be an object or a string")
+	}
+
+	key, err := tagsListElementAttribute(attrs, tagsListAttrKey, tagsListAttrKeyLower)
+	if err != nil {
+		return "", nil, err
+	}
+	if key == nil || *key == "" {
+		return "", nil, errors.New("key must be specified")
+	}
+
+	value, err := tagsListElementAttribute(attrs, tagsListAttrValue, tagsListAttrValueLower)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return *key, value, nil
+}
+
+// tagsListElementAttribute returns the string value of the first of the named attributes that is present.
+func tagsListElementAttribute(attrs map[string]attr.Value, names ...string) (*string, error) {
+	for _, name := range names {
+		v, ok := attrs[name]
+		if !ok {
+			continue
+		}
+
+		if v.IsNull() {
+			return nil, nil
+		}
+
+		s, ok := v.(basetypes.StringValue)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a string", name)
+		}
+
+		return s.ValueStringPointer(), nil
+	}
+
+	return nil, nil
+}
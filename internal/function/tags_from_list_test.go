@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestTagsFromListFunction_known(t *testing.T) {
+	t.Parallel()
+
+	expected := knownvalue.MapExact(map[string]knownvalue.Check{
+		"Environment": knownvalue.StringExact("test"),
+		"Name":        knownvalue.StringExact("example"),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsFromListFunctionConfig(`[{ Key = "Name", Value = "example" }, { Key = "Environment", Value = "test" }]`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", expected),
+				},
+			},
+			{
+				Config: testTagsFromListFunctionConfig(`[{ key = "Name", value = "example", propagate_at_launch = true }, { key = "Environment", value = "test", propagate_at_launch = false }]`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", expected),
+				},
+			},
+			{
+				Config: testTagsFromListFunctionConfig(`["Name=example", "Environment=test"]`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", expected),
+				},
+			},
+			{
+				Config: testTagsFromListFunctionConfig(`provider::aws::tags_to_list({ Name = "example", Environment = "test" }, "api")`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", expected),
+				},
+			},
+		},
+	})
+}
+
+func TestTagsFromListFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testTagsFromListFunctionConfig(`["Name=example", "Name=duplicate"]`),
+				ExpectError: regexache.MustCompile(`duplicate[\s\n]*key`),
+			},
+			{
+				Config:      testTagsFromListFunctionConfig(`["Name"]`),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*of[\s\n]*the[\s\n]*form[\s\n]*key=value`),
+			},
+			{
+				Config:      testTagsFromListFunctionConfig(`{ Name = "example" }`),
+				ExpectError: regexache.MustCompile(`tags[\s\n]*must[\s\n]*be[\s\n]*a[\s\n]*list`),
+			},
+		},
+	})
+}
+
+func testTagsFromListFunctionConfig(tags string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::tags_from_list(%[1]s)
+}
+`, tags)
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+const (
+	// [{Key = "k", Value = "v"}], as used by most AWS APIs.
+	tagsListFormatAPI = "api"
+	// [{key = "k", value = "v", propagate_at_launch = true}], as used by aws_autoscaling_group.
+	tagsListFormatAutoScaling = "autoscaling"
+	// [{key = "k", value = "v"}].
+	tagsListFormatLowercase = "lowercase"
+	// ["k=v"].
+	tagsListFormatStrings = "strings"
+)
+
+const (
+	tagsListAttrKey               = "Key"
+	tagsListAttrKeyLower          = "key"
+	tagsListAttrPropagateAtLaunch = "propagate_at_launch"
+	tagsListAttrValue             = "Value"
+	tagsListAttrValueLower        = "value"
+)
+
+var _ function.Function = tagsToListFunction{}
+
+func NewTagsToListFunction() function.Function {
+	return &tagsToListFunction{}
+}
+
+type tagsToListFunction struct{}
+
+func (f tagsToListFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_to_list"
+}
+
+func (f tagsToListFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "tags_to_list Function",
+		MarkdownDescription: "Converts a map of tags to a list of tags, sorted by key",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "tags",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Map of tags to convert",
+			},
+			function.StringParameter{
+				Name:                "format",
+				MarkdownDescription: "Format of the list elements. Valid values are `api`, `lowercase`, `autoscaling` and `strings`",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (f tagsToListFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tags map[string]string
+	var format string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tags, &format))
+	if resp.Error != nil {
+		return
+	}
+
+	kvtags := tftags.New(ctx, tags)
+	keys := kvtags.Keys()
+	slices.Sort(keys)
+
+	var elementType attr.Type
+	var elements []attr.Value
+
+	switch format {
+	case tagsListFormatStrings:
+		elementType = types.StringType
+		for _, k := range keys {
+			elements = append(elements, types.StringValue(k+"="+kvtags.KeyTagData(k).ValueString()))
+		}
+	case tagsListFormatAPI, tagsListFormatAutoScaling, tagsListFormatLowercase:
+		attrTypes := map[string]attr.Type{
+			tagsListAttrKey:   types.StringType,
+			tagsListAttrValue: types.StringType,
+		}
+		if format != tagsListFormatAPI {
+			attrTypes = map[string]attr.Type{
+				tagsListAttrKeyLower:   types.StringType,
+				tagsListAttrValueLower: types.StringType,
+			}
+		}
+		if format == tagsListFormatAutoScaling {
+			attrTypes[tagsListAttrPropagateAtLaunch] = types.BoolType
+		}
+		elementType = types.ObjectType{AttrTypes: attrTypes}
+
+		for _, k := range keys {
+			key, value := types.StringValue(k), types.StringValue(kvtags.KeyTagData(k).ValueString())
+			attrs := map[string]attr.Value{
+				tagsListAttrKey:   key,
+				tagsListAttrValue: value,
+			}
+			if format != tagsListFormatAPI {
+				attrs = map[string]attr.Value{
+					tagsListAttrKeyLower:   key,
+					tagsListAttrValueLower: value,
+				}
+			}
+			if format == tagsListFormatAutoScaling {
+				attrs[tagsListAttrPropagateAtLaunch] = types.BoolValue(true)
+			}
+
+			element, d := types.ObjectValue(attrTypes, attrs)
+			if d.HasError() {
+				resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+				return
+			}
+			elements = append(elements, element)
+		}
+	default:
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("unsupported format (%s)", format)))
+		return
+	}
+
+	result, d := types.ListValue(elementType, elements)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.DynamicValue(result)))
+}
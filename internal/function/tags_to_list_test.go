@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestTagsToListFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsToListFunctionConfig("api"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"Key":   knownvalue.StringExact("Environment"),
+							"Value": knownvalue.StringExact("test"),
+						}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"Key":   knownvalue.StringExact("Name"),
+							"Value": knownvalue.StringExact("example"),
+						}),
+					})),
+				},
+			},
+			{
+				Config: testTagsToListFunctionConfig("lowercase"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"key":   knownvalue.StringExact("Environment"),
+							"value": knownvalue.StringExact("test"),
+						}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"key":   knownvalue.StringExact("Name"),
+							"value": knownvalue.StringExact("example"),
+						}),
+					})),
+				},
+			},
+			{
+				Config: testTagsToListFunctionConfig("autoscaling"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"key":                 knownvalue.StringExact("Environment"),
+							"value":               knownvalue.StringExact("test"),
+							"propagate_at_launch": knownvalue.Bool(true),
+						}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"key":                 knownvalue.StringExact("Name"),
+							"value":               knownvalue.StringExact("example"),
+							"propagate_at_launch": knownvalue.Bool(true),
+						}),
+					})),
+				},
+			},
+			{
+				Config: testTagsToListFunctionConfig("strings"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("Environment=test"),
+						knownvalue.StringExact("Name=example"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestTagsToListFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testTagsToListFunctionConfig("invalid"),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*format`),
+			},
+		},
+	})
+}
+
+func testTagsToListFunctionConfig(format string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::tags_to_list({ Name = "example", Environment = "test" }, %[1]q)
+}
+`, format)
+}
@@ -396,7 +396,23 @@ func (p *frameworkProvider) Actions(ctx context.Context) []func() action.Action
 func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
+		tffunction.NewARNMatchFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewDynamoDBMarshalFunction,
+		tffunction.NewDynamoDBUnmarshalFunction,
+		tffunction.NewEC2InstanceTypeParseFunction,
+		tffunction.NewIAMPolicyEquivalentFunction,
+		tffunction.NewIAMPolicyEvaluateFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewRegionInfoFunction,
+		tffunction.NewS3URIBuildFunction,
+		tffunction.NewS3URIParseFunction,
+		tffunction.NewScheduleExpressionNextFunction,
+		tffunction.NewScheduleExpressionValidateFunction,
+		tffunction.NewTagsFilterAWSPrefixFunction,
+		tffunction.NewTagsFromListFunction,
+		tffunction.NewTagsToListFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 		tffunction.NewUserAgentFunction,
 	}
@@ -0,0 +1,333 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startInstanceRefreshPollInterval defines polling cadence for the start instance refresh action.
+const startInstanceRefreshPollInterval = 15 * time.Second
+
+// @Action(aws_autoscaling_start_instance_refresh, name="Start Instance Refresh")
+func newStartInstanceRefreshAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startInstanceRefreshAction{}, nil
+}
+
+var (
+	_ action.Action = (*startInstanceRefreshAction)(nil)
+)
+
+type startInstanceRefreshAction struct {
+	framework.ActionWithModel[startInstanceRefreshActionModel]
+}
+
+type startInstanceRefreshActionModel struct {
+	framework.WithRegionModel
+	AutoScalingGroupName types.String                                             `tfsdk:"autoscaling_group_name"`
+	Preferences          fwtypes.ListNestedObjectValueOf[refreshPreferencesModel] `tfsdk:"preferences"`
+	Strategy             fwtypes.StringEnum[awstypes.RefreshStrategy]             `tfsdk:"strategy"`
+	Timeout              types.Int64                                              `tfsdk:"timeout"`
+}
+
+type refreshPreferencesModel struct {
+	AlarmSpecification        fwtypes.ListNestedObjectValueOf[alarmSpecificationModel] `tfsdk:"alarm_specification"`
+	AutoRollback              types.Bool                                               `tfsdk:"auto_rollback"`
+	CheckpointDelay           types.Int64                                              `tfsdk:"checkpoint_delay"`
+	CheckpointPercentages     fwtypes.ListOfInt64                                      `tfsdk:"checkpoint_percentages"`
+	InstanceWarmup            types.Int64                                              `tfsdk:"instance_warmup"`
+	MaxHealthyPercentage      types.Int64                                              `tfsdk:"max_healthy_percentage"`
+	MinHealthyPercentage      types.Int64                                              `tfsdk:"min_healthy_percentage"`
+	ScaleInProtectedInstances fwtypes.StringEnum[awstypes.ScaleInProtectedInstances]   `tfsdk:"scale_in_protected_instances"`
+	SkipMatching              types.Bool                                               `tfsdk:"skip_matching"`
+	StandbyInstances          fwtypes.StringEnum[awstypes.StandbyInstances]            `tfsdk:"standby_instances"`
+}
+
+type alarmSpecificationModel struct {
+	Alarms fwtypes.ListOfString `tfsdk:"alarms"`
+}
+
+func (a *startInstanceRefreshAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an instance refresh of an Auto Scaling group and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"autoscaling_group_name": schema.StringAttribute{
+				Description: "Name of the Auto Scaling group",
+				Required:    true,
+			},
+			"strategy": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.RefreshStrategy](),
+				Description: "Strategy to use for the instance refresh (default: Rolling)",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the instance refresh to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"preferences": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[refreshPreferencesModel](ctx),
+				Description: "Preferences for the instance refresh",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auto_rollback": schema.BoolAttribute{
+							Description: "Whether to roll back the Auto Scaling group to its previous configuration if the instance refresh fails",
+							Optional:    true,
+						},
+						"checkpoint_delay": schema.Int64Attribute{
+							Description: "Number of seconds to wait after a checkpoint",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 172800),
+							},
+						},
+						"checkpoint_percentages": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfInt64Type,
+							Description: "Percentages of the instance refresh at which to wait checkpoint_delay seconds",
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.ValueInt64sAre(int64validator.Between(1, 100)),
+							},
+						},
+						"instance_warmup": schema.Int64Attribute{
+							Description: "Number of seconds until a newly launched instance is configured and ready to use",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"max_healthy_percentage": schema.Int64Attribute{
+							Description: "Maximum percentage of the desired capacity that can be in service and healthy, or pending, during the instance refresh",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(100, 200),
+							},
+						},
+						"min_healthy_percentage": schema.Int64Attribute{
+							Description: "Minimum percentage of the desired capacity that must remain in service and healthy during the instance refresh",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 100),
+							},
+						},
+						"scale_in_protected_instances": schema.StringAttribute{
+							CustomType:  fwtypes.StringEnumType[awstypes.ScaleInProtectedInstances](),
+							Description: "Behavior when instances protected from scale in are found",
+							Optional:    true,
+						},
+						"skip_matching": schema.BoolAttribute{
+							Description: "Whether to skip replacing instances that already match the desired configuration",
+							Optional:    true,
+						},
+						"standby_instances": schema.StringAttribute{
+							CustomType:  fwtypes.StringEnumType[awstypes.StandbyInstances](),
+							Description: "Behavior when instances in Standby state are found",
+							Optional:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"alarm_specification": schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[alarmSpecificationModel](ctx),
+							Description: "CloudWatch alarms that cause the instance refresh to fail when they enter the ALARM state",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"alarms": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										Description: "Names of the CloudWatch alarms",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *startInstanceRefreshAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startInstanceRefreshActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().AutoScalingClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, config.AutoScalingGroupName)
+
+	// Set default timeout if not provided
+	timeout := fwactions.TimeoutOr(config.Timeout, 60*time.Minute)
+
+	tflog.Info(ctx, "Starting Auto Scaling start instance refresh action", map[string]any{
+		"autoscaling_group_name": name,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting instance refresh for Auto Scaling group %s...", name)
+
+	group, err := findGroupByName(ctx, conn, name)
+	if retry.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Auto Scaling Group Not Found",
+			fmt.Sprintf("Auto Scaling group %s was not found", name),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Auto Scaling Group",
+			fmt.Sprintf("Could not describe Auto Scaling group %s: %s", name, err),
+		)
+		return
+	}
+
+	var input autoscaling.StartInstanceRefreshInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// "The AutoRollback parameter cannot be set to true when the DesiredConfiguration parameter is empty".
+	if input.Preferences != nil && aws.ToBool(input.Preferences.AutoRollback) {
+		input.DesiredConfiguration = &awstypes.DesiredConfiguration{
+			LaunchTemplate:       group.LaunchTemplate,
+			MixedInstancesPolicy: group.MixedInstancesPolicy,
+		}
+	}
+
+	output, err := conn.StartInstanceRefresh(ctx, &input)
+	if errs.IsA[*awstypes.InstanceRefreshInProgressFault](err) {
+		resp.Diagnostics.AddError(
+			"Instance Refresh In Progress",
+			fmt.Sprintf("Auto Scaling group %s already has an instance refresh in progress: %s", name, err),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Instance Refresh",
+			fmt.Sprintf("Could not start instance refresh for Auto Scaling group %s: %s", name, err),
+		)
+		return
+	}
+
+	id := aws.ToString(output.InstanceRefreshId)
+	cb(ctx, "Instance refresh %s started for Auto Scaling group %s, waiting for completion...", id, name)
+
+	// Wait for the instance refresh to complete with periodic progress updates using actionwait
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.InstanceRefresh], error) {
+		input := autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: aws.String(name),
+			InstanceRefreshIds:   []string{id},
+		}
+		refresh, derr := findInstanceRefresh(ctx, conn, &input)
+		if derr != nil {
+			return actionwait.FetchResult[*awstypes.InstanceRefresh]{}, fmt.Errorf("describing instance refresh: %w", derr)
+		}
+		return actionwait.FetchResult[*awstypes.InstanceRefresh]{Status: actionwait.Status(refresh.Status), Value: refresh}, nil
+	}, actionwait.Options[*awstypes.InstanceRefresh]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startInstanceRefreshPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.InstanceRefreshStatusSuccessful)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusPending),
+			actionwait.Status(awstypes.InstanceRefreshStatusInProgress),
+			actionwait.Status(awstypes.InstanceRefreshStatusBaking),
+			actionwait.Status(awstypes.InstanceRefreshStatusCancelling),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackInProgress),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusFailed),
+			actionwait.Status(awstypes.InstanceRefreshStatusCancelled),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackFailed),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackSuccessful),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if refresh, ok := fr.Value.(*awstypes.InstanceRefresh); ok && refresh != nil {
+				cb(ctx, "Instance refresh %s is %s: %d%% complete, %d instances remaining to update", id, fr.Status, aws.ToInt32(refresh.PercentageComplete), aws.ToInt32(refresh.InstancesToUpdate))
+				return
+			}
+			cb(ctx, "Instance refresh %s is currently in state '%s', continuing to wait for 'Successful'...", id, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Instance Refresh",
+				fmt.Sprintf("Instance refresh %s of Auto Scaling group %s did not complete within %s: %s", id, name, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			detail := fmt.Sprintf("Instance refresh %s of Auto Scaling group %s finished with status %s", id, name, failureErr.Status)
+			if fr.Value != nil {
+				if reason := aws.ToString(fr.Value.StatusReason); reason != "" {
+					detail += ": " + reason
+				}
+			}
+			resp.Diagnostics.AddError("Instance Refresh Failed", detail)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Instance Refresh State",
+				fmt.Sprintf("Instance refresh %s of Auto Scaling group %s entered unexpected state: %s", id, name, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Instance Refresh",
+				fmt.Sprintf("Error while waiting for instance refresh %s of Auto Scaling group %s: %s", id, name, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	cb(ctx, "Instance refresh %s of Auto Scaling group %s completed successfully", id, name)
+
+	tflog.Info(ctx, "Auto Scaling start instance refresh action completed successfully", map[string]any{
+		"autoscaling_group_name": name,
+		"instance_refresh_id":    id,
+	})
+}
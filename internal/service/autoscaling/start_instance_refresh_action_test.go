@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling_test
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAutoScalingStartInstanceRefreshAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group awstypes.AutoScalingGroup
+	resourceName := "aws_autoscaling_group.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_launchTemplate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, t, resourceName, &group),
+					testAccCheckInstanceRefreshCount(ctx, t, &group, 0),
+				),
+			},
+			{
+				Config: testAccStartInstanceRefreshActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, t, resourceName, &group),
+					testAccCheckInstanceRefreshCount(ctx, t, &group, 1),
+					testAccCheckInstanceRefreshStatus(ctx, t, &group, 0, awstypes.InstanceRefreshStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAutoScalingStartInstanceRefreshAction_preferences(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group awstypes.AutoScalingGroup
+	resourceName := "aws_autoscaling_group.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartInstanceRefreshActionConfig_preferences(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, t, resourceName, &group),
+					testAccCheckInstanceRefreshCount(ctx, t, &group, 1),
+					testAccCheckInstanceRefreshStatus(ctx, t, &group, 0, awstypes.InstanceRefreshStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
+func testAccStartInstanceRefreshActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGroupConfig_launchTemplate(rName), `
+action "aws_autoscaling_start_instance_refresh" "test" {
+  config {
+    autoscaling_group_name = aws_autoscaling_group.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_autoscaling_start_instance_refresh.test]
+    }
+  }
+}
+`)
+}
+
+func testAccStartInstanceRefreshActionConfig_preferences(rName string) string {
+	return acctest.ConfigCompose(testAccGroupConfig_launchTemplate(rName), `
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = aws_autoscaling_group.test.name
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 2
+  metric_name         = "CPUUtilization"
+  namespace           = "AWS/EC2"
+  period              = 120
+  statistic           = "Average"
+  threshold           = 80
+
+  dimensions = {
+    AutoScalingGroupName = aws_autoscaling_group.test.name
+  }
+}
+
+action "aws_autoscaling_start_instance_refresh" "test" {
+  config {
+    autoscaling_group_name = aws_autoscaling_group.test.name
+    strategy               = "Rolling"
+
+    preferences {
+      auto_rollback          = true
+      checkpoint_delay       = 60
+      checkpoint_percentages = [50, 100]
+      instance_warmup        = 0
+      min_healthy_percentage = 90
+      max_healthy_percentage = 110
+      skip_matching          = true
+
+      alarm_specification {
+        alarms = [aws_cloudwatch_metric_alarm.test.alarm_name]
+      }
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_autoscaling_start_instance_refresh.test]
+    }
+  }
+}
+`)
+}
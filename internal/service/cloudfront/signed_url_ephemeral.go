@@ -0,0 +1,312 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1" // nosemgrep: go/sast/internal/crypto/sha1 -- CloudFront signed URLs and cookies require RSA-SHA1 signatures
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	signedURLDefaultExpiresIn = 3600 // 1 hour.
+)
+
+// @EphemeralResource("aws_cloudfront_signed_url", name="Signed URL")
+func newSignedURLEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &signedURLEphemeralResource{}, nil
+}
+
+type signedURLEphemeralResource struct {
+	framework.EphemeralResourceWithModel[signedURLEphemeralResourceModel]
+}
+
+func (e *signedURLEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expires_at": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Computed:    true,
+				Description: "The time after which the signed URL and cookies are no longer valid, in RFC3339 format.",
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "The number of seconds for which the signed URL and cookies are valid. Default is 3600 seconds (1 hour).",
+			},
+			"key_pair_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the CloudFront public key whose private key is used for signing.",
+			},
+			names.AttrPrivateKey: schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The PEM-encoded RSA private key used for signing.",
+			},
+			"signed_cookies": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The signed cookies, keyed by cookie name.",
+			},
+			"signed_url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The signed URL.",
+			},
+			names.AttrURL: schema.StringAttribute{
+				Required:    true,
+				Description: "The URL to sign.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"custom_policy": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[signedURLCustomPolicyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Signs a custom policy rather than a canned policy.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrIPAddress: schema.StringAttribute{
+							CustomType:  fwtypes.CIDRBlockType,
+							Optional:    true,
+							Description: "The IP address range, in CIDR notation, from which requests are allowed.",
+						},
+						"resource": schema.StringAttribute{
+							Optional:    true,
+							Description: "The resource, which may contain `*` wildcards, to which the policy applies. Defaults to `url`.",
+						},
+						names.AttrStartTime: schema.StringAttribute{
+							CustomType:  timetypes.RFC3339Type{},
+							Optional:    true,
+							Description: "The time, in RFC3339 format, before which requests are denied.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *signedURLEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data signedURLEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	privateKey, err := parseSignedURLPrivateKey(data.PrivateKey.ValueString())
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	rawURL := data.URL.ValueString()
+	expiresIn := int64(signedURLDefaultExpiresIn)
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = data.ExpiresIn.ValueInt64()
+	}
+	expiresAt := time.Now().UTC().Truncate(time.Second).Add(time.Duration(expiresIn) * time.Second)
+
+	statement := signedURLPolicyStatement{
+		Resource: rawURL,
+		Condition: signedURLPolicyCondition{
+			DateLessThan: &signedURLPolicyEpochTime{EpochTime: expiresAt.Unix()},
+		},
+	}
+	customPolicy, diags := data.CustomPolicy.ToPtr(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, diags)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if customPolicy != nil {
+		if v := customPolicy.Resource.ValueString(); v != "" {
+			statement.Resource = v
+		}
+		if !customPolicy.IPAddress.IsNull() {
+			statement.Condition.IPAddress = &signedURLPolicySourceIP{SourceIP: customPolicy.IPAddress.ValueString()}
+		}
+		if !customPolicy.StartTime.IsNull() {
+			startTime, diags := customPolicy.StartTime.ValueRFC3339Time()
+			smerr.AddEnrich(ctx, &response.Diagnostics, diags)
+			if response.Diagnostics.HasError() {
+				return
+			}
+			if !startTime.Before(expiresAt) {
+				smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("custom policy start time (%s) must be before expiration time (%s)", startTime.Format(time.RFC3339), expiresAt.Format(time.RFC3339)))
+				return
+			}
+			statement.Condition.DateGreaterThan = &signedURLPolicyEpochTime{EpochTime: startTime.Unix()}
+		}
+	}
+
+	policy, err := encodeSignedURLPolicy(signedURLPolicy{Statement: []signedURLPolicyStatement{statement}})
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	signature, err := signSignedURLPolicy(privateKey, policy)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("signing CloudFront policy: %w", err))
+		return
+	}
+
+	keyPairID := data.KeyPairID.ValueString()
+	cookies := map[string]attr.Value{
+		"CloudFront-Key-Pair-Id": types.StringValue(keyPairID),
+		"CloudFront-Signature":   types.StringValue(signature),
+	}
+	var params []string
+	if customPolicy == nil {
+		expires := strconv.FormatInt(expiresAt.Unix(), 10)
+		cookies["CloudFront-Expires"] = types.StringValue(expires)
+		params = append(params, "Expires="+expires)
+	} else {
+		encodedPolicy := encodeSignedURLBase64(policy)
+		cookies["CloudFront-Policy"] = types.StringValue(encodedPolicy)
+		params = append(params, "Policy="+encodedPolicy)
+	}
+	params = append(params, "Signature="+signature, "Key-Pair-Id="+url.QueryEscape(keyPairID))
+
+	separator := "?"
+	if strings.Contains(rawURL, "?") {
+		separator = "&"
+	}
+
+	data.ExpiresAt = timetypes.NewRFC3339TimeValue(expiresAt)
+	data.SignedCookies = fwtypes.NewMapValueOfMust[types.String](ctx, cookies)
+	data.SignedURL = fwflex.StringValueToFramework(ctx, rawURL+separator+strings.Join(params, "&"))
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type signedURLEphemeralResourceModel struct {
+	CustomPolicy  fwtypes.ListNestedObjectValueOf[signedURLCustomPolicyModel] `tfsdk:"custom_policy"`
+	ExpiresAt     timetypes.RFC3339                                           `tfsdk:"expires_at"`
+	ExpiresIn     types.Int64                                                 `tfsdk:"expires_in"`
+	KeyPairID     types.String                                                `tfsdk:"key_pair_id"`
+	PrivateKey    types.String                                                `tfsdk:"private_key"`
+	SignedCookies fwtypes.MapOfString                                         `tfsdk:"signed_cookies"`
+	SignedURL     types.String                                                `tfsdk:"signed_url"`
+	URL           types.String                                                `tfsdk:"url"`
+}
+
+type signedURLCustomPolicyModel struct {
+	IPAddress fwtypes.CIDRBlock `tfsdk:"ip_address"`
+	Resource  types.String      `tfsdk:"resource"`
+	StartTime timetypes.RFC3339 `tfsdk:"start_time"`
+}
+
+// signedURLPolicy is a CloudFront signed URL or signed cookie policy.
+// See https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/private-content-creating-signed-url-custom-policy.html.
+// A policy with only a DateLessThan condition is a canned policy.
+type signedURLPolicy struct {
+	Statement []signedURLPolicyStatement `json:"Statement"`
+}
+
+type signedURLPolicyStatement struct {
+	Resource  string                   `json:"Resource"`
+	Condition signedURLPolicyCondition `json:"Condition"`
+}
+
+type signedURLPolicyCondition struct {
+	DateLessThan    *signedURLPolicyEpochTime `json:"DateLessThan"`
+	DateGreaterThan *signedURLPolicyEpochTime `json:"DateGreaterThan,omitempty"`
+	IPAddress       *signedURLPolicySourceIP  `json:"IpAddress,omitempty"`
+}
+
+type signedURLPolicyEpochTime struct {
+	EpochTime int64 `json:"AWS:EpochTime"`
+}
+
+type signedURLPolicySourceIP struct {
+	SourceIP string `json:"AWS:SourceIp"`
+}
+
+// encodeSignedURLPolicy returns the compact JSON encoding of the specified policy.
+// Characters such as '&' in the resource URL are not HTML-escaped.
+func encodeSignedURLPolicy(policy signedURLPolicy) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(policy); err != nil {
+		return nil, fmt.Errorf("encoding CloudFront policy: %w", err)
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// signSignedURLPolicy returns the URL-safe encoding of the RSA-SHA1 signature of the specified policy.
+func signSignedURLPolicy(privateKey *rsa.PrivateKey, policy []byte) (string, error) {
+	hash := sha1.Sum(policy)
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA1, hash[:])
+	if err != nil {
+		return "", err
+	}
+
+	return encodeSignedURLBase64(signature), nil
+}
+
+// encodeSignedURLBase64 base64-encodes the specified value, replacing characters which are invalid in URL query strings
+// as described in https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/private-content-linux-openssl.html.
+func encodeSignedURLBase64(v []byte) string {
+	return strings.NewReplacer("+", "-", "=", "_", "/", "~").Replace(base64.StdEncoding.EncodeToString(v))
+}
+
+// parseSignedURLPrivateKey parses a PEM-encoded PKCS #1 or PKCS #8 RSA private key.
+func parseSignedURLPrivateKey(v string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(v))
+	if block == nil {
+		return nil, errors.New("decoding CloudFront private key: no PEM data found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing CloudFront private key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("parsing CloudFront private key: unsupported key type (%T), expected RSA", key)
+	}
+
+	return rsaKey, nil
+}
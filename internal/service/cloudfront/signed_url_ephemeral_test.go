@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontSignedURLEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	publicKey := acctest.TLSRSAPublicKeyPEM(t, privateKey)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignedURLEphemeralConfig_basic(rName, privateKey, publicKey),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_url"), knownvalue.StringRegexp(regexp.MustCompile(`^https://example\.cloudfront\.net/private/index\.html\?Expires=\d+&Signature=[0-9A-Za-z~_-]+&Key-Pair-Id=[0-9A-Z]+$`))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_cookies"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"CloudFront-Expires":     knownvalue.StringRegexp(regexp.MustCompile(`^\d+$`)),
+						"CloudFront-Key-Pair-Id": knownvalue.NotNull(),
+						"CloudFront-Signature":   knownvalue.NotNull(),
+					})),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_at"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontSignedURLEphemeral_customPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	publicKey := acctest.TLSRSAPublicKeyPEM(t, privateKey)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignedURLEphemeralConfig_customPolicy(rName, privateKey, publicKey),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_url"), knownvalue.StringRegexp(regexp.MustCompile(`^https://example\.cloudfront\.net/private/index\.html\?version=1&Policy=[0-9A-Za-z~_-]+&Signature=[0-9A-Za-z~_-]+&Key-Pair-Id=[0-9A-Z]+$`))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_cookies"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"CloudFront-Key-Pair-Id": knownvalue.NotNull(),
+						"CloudFront-Policy":      knownvalue.NotNull(),
+						"CloudFront-Signature":   knownvalue.NotNull(),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccSignedURLEphemeralConfig_base(rName, publicKey string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_public_key" "test" {
+  encoded_key = "%[2]s"
+  name        = %[1]q
+}
+`, rName, acctest.TLSPEMEscapeNewlines(publicKey))
+}
+
+func testAccSignedURLEphemeralConfig_basic(rName, privateKey, publicKey string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cloudfront_signed_url.test"),
+		testAccSignedURLEphemeralConfig_base(rName, publicKey),
+		fmt.Sprintf(`
+ephemeral "aws_cloudfront_signed_url" "test" {
+  url         = "https://example.cloudfront.net/private/index.html"
+  key_pair_id = aws_cloudfront_public_key.test.id
+  private_key = "%[1]s"
+}
+`, acctest.TLSPEMEscapeNewlines(privateKey)))
+}
+
+func testAccSignedURLEphemeralConfig_customPolicy(rName, privateKey, publicKey string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cloudfront_signed_url.test"),
+		testAccSignedURLEphemeralConfig_base(rName, publicKey),
+		fmt.Sprintf(`
+ephemeral "aws_cloudfront_signed_url" "test" {
+  url         = "https://example.cloudfront.net/private/index.html?version=1"
+  key_pair_id = aws_cloudfront_public_key.test.id
+  private_key = "%[1]s"
+  expires_in  = 600
+
+  custom_policy {
+    resource   = "https://example.cloudfront.net/private/*"
+    ip_address = "192.0.2.0/24"
+    start_time = "2020-01-01T00:00:00Z"
+  }
+}
+`, acctest.TLSPEMEscapeNewlines(privateKey)))
+}
@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeartifact/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_codeartifact_authorization_token, name="Authorization Token")
+func newAuthorizationTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authorizationTokenEphemeralResource{}, nil
+}
+
+type authorizationTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[authorizationTokenEphemeralResourceModel]
+}
+
+func (e *authorizationTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrDomain: schema.StringAttribute{
+				Required: true,
+			},
+			"domain_owner": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.Between(900, 43200),
+						int64validator.OneOf(0),
+					),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrFormat: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PackageFormat](),
+				Optional:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("repository")),
+				},
+			},
+			"repository": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot(names.AttrFormat)),
+				},
+			},
+			"repository_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *authorizationTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().CodeArtifactClient(ctx)
+	data := authorizationTokenEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := data.Domain.ValueString()
+	domainOwner := data.DomainOwner.ValueString()
+	if domainOwner == "" {
+		domainOwner = e.Meta().AccountID(ctx)
+	}
+
+	input := codeartifact.GetAuthorizationTokenInput{
+		Domain:          aws.String(domainName),
+		DomainOwner:     aws.String(domainOwner),
+		DurationSeconds: fwflex.Int64FromFramework(ctx, data.DurationSeconds),
+	}
+
+	output, err := conn.GetAuthorizationToken(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("reading CodeArtifact Authorization Token: %w", err), smerr.ID, domainName)
+		return
+	}
+
+	data.AuthorizationToken = fwflex.StringToFramework(ctx, output.AuthorizationToken)
+	data.DomainOwner = types.StringValue(domainOwner)
+	data.Expiration = timetypes.NewRFC3339TimePointerValue(output.Expiration)
+
+	if !data.Repository.IsNull() {
+		repositoryName := data.Repository.ValueString()
+		input := codeartifact.GetRepositoryEndpointInput{
+			Domain:      aws.String(domainName),
+			DomainOwner: aws.String(domainOwner),
+			Format:      data.Format.ValueEnum(),
+			Repository:  aws.String(repositoryName),
+		}
+
+		output, err := conn.GetRepositoryEndpoint(ctx, &input)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("reading CodeArtifact Repository Endpoint: %w", err), smerr.ID, repositoryName)
+			return
+		}
+
+		data.RepositoryEndpoint = fwflex.StringToFramework(ctx, output.RepositoryEndpoint)
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type authorizationTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	AuthorizationToken types.String                               `tfsdk:"authorization_token"`
+	Domain             types.String                               `tfsdk:"domain"`
+	DomainOwner        types.String                               `tfsdk:"domain_owner"`
+	DurationSeconds    types.Int64                                `tfsdk:"duration_seconds"`
+	Expiration         timetypes.RFC3339                          `tfsdk:"expiration"`
+	Format             fwtypes.StringEnum[awstypes.PackageFormat] `tfsdk:"format"`
+	Repository         types.String                               `tfsdk:"repository"`
+	RepositoryEndpoint types.String                               `tfsdk:"repository_endpoint"`
+}
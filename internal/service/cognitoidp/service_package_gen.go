@@ -20,6 +20,23 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
+	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newUserPoolClientCredentialsTokenEphemeralResource,
+			TypeName: "aws_cognito_user_pool_client_credentials_token",
+			Name:     "User Pool Client Credentials Token",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newUserPoolClientSecretEphemeralResource,
+			TypeName: "aws_cognito_user_pool_client_secret",
+			Name:     "User Pool Client Secret",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
 		{
@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_cognito_user_pool_client_credentials_token", name="User Pool Client Credentials Token")
+func newUserPoolClientCredentialsTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &userPoolClientCredentialsTokenEphemeralResource{}, nil
+}
+
+type userPoolClientCredentialsTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[userPoolClientCredentialsTokenEphemeralResourceModel]
+}
+
+func (e *userPoolClientCredentialsTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrClientID: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrClientSecret: schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			names.AttrDomain: schema.StringAttribute{
+				Optional: true,
+			},
+			"expires_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Computed: true,
+			},
+			"scopes": schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Optional:   true,
+				Computed:   true,
+			},
+			"token_type": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrUserPoolID: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *userPoolClientCredentialsTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().CognitoIDPClient(ctx)
+	var data userPoolClientCredentialsTokenEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	userPoolID, clientID := data.UserPoolID.ValueString(), data.ClientID.ValueString()
+
+	clientSecret := data.ClientSecret.ValueString()
+	if clientSecret == "" {
+		client, err := findUserPoolClientByTwoPartKey(ctx, conn, userPoolID, clientID)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("reading Cognito User Pool Client (%s): %w", clientID, err), smerr.ID, clientID)
+			return
+		}
+
+		clientSecret = aws.ToString(client.ClientSecret)
+	}
+
+	domain := data.Domain.ValueString()
+	if domain == "" {
+		userPool, err := findUserPoolByID(ctx, conn, userPoolID)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("reading Cognito User Pool (%s): %w", userPoolID, err), smerr.ID, userPoolID)
+			return
+		}
+
+		domain = aws.ToString(userPool.CustomDomain)
+		if domain == "" {
+			domain = aws.ToString(userPool.Domain)
+		}
+		if domain == "" {
+			smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("Cognito User Pool (%s) has no domain", userPoolID), smerr.ID, userPoolID)
+			return
+		}
+	}
+
+	// Custom domains are fully-qualified domain names. Prefix domains cannot contain '.'.
+	endpoint := fmt.Sprintf("https://%s/oauth2/token", domain)
+	if !strings.Contains(domain, ".") {
+		endpoint = fmt.Sprintf("https://%s.auth.%s.amazoncognito.com/oauth2/token", domain, e.Meta().Region(ctx))
+	}
+
+	requestTime := time.Now()
+	token, err := clientCredentialsToken(ctx, e.Meta().HTTPClient(ctx), endpoint, clientID, clientSecret, fwflex.ExpandFrameworkStringValueSet(ctx, data.Scopes))
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, clientID)
+		return
+	}
+
+	scopes, err := accessTokenScopes(token.AccessToken)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, clientID)
+		return
+	}
+
+	data.AccessToken = types.StringValue(token.AccessToken)
+	data.ExpiresAt = timetypes.NewRFC3339TimeValue(requestTime.UTC().Truncate(time.Second).Add(time.Duration(token.ExpiresIn) * time.Second))
+	data.ExpiresIn = types.Int64Value(token.ExpiresIn)
+	data.Scopes = fwflex.FlattenFrameworkStringValueSetOfString(ctx, scopes)
+	data.TokenType = types.StringValue(token.TokenType)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type userPoolClientCredentialsTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	AccessToken  types.String        `tfsdk:"access_token"`
+	ClientID     types.String        `tfsdk:"client_id"`
+	ClientSecret types.String        `tfsdk:"client_secret"`
+	Domain       types.String        `tfsdk:"domain"`
+	ExpiresAt    timetypes.RFC3339   `tfsdk:"expires_at"`
+	ExpiresIn    types.Int64         `tfsdk:"expires_in"`
+	Scopes       fwtypes.SetOfString `tfsdk:"scopes"`
+	TokenType    types.String        `tfsdk:"token_type"`
+	UserPoolID   types.String        `tfsdk:"user_pool_id"`
+}
+
+type oauth2TokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	ExpiresIn        int64  `json:"expires_in"`
+	TokenType        string `json:"token_type"`
+}
+
+// clientCredentialsToken performs the OAuth 2.0 client credentials grant against the specified token endpoint.
+// A default HTTP client is used if client is nil.
+// See https://docs.aws.amazon.com/cognito/latest/developerguide/token-endpoint.html.
+func clientCredentialsToken(ctx context.Context, client *http.Client, endpoint, clientID, clientSecret string, scopes []string) (*oauth2TokenResponse, error) {
+	form := url.Values{
+		"client_id":  {clientID},
+		"grant_type": {"client_credentials"},
+	}
+	if len(scopes) > 0 {
+		form.Set("scope", strings.Join(scopes, " "))
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if clientSecret != "" {
+		request.SetBasicAuth(clientID, clientSecret)
+	}
+
+	if client == nil {
+		client = cleanhttp.DefaultClient()
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP POST (%s): %w", endpoint, err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body (%s): %w", endpoint, err)
+	}
+
+	var output oauth2TokenResponse
+	if err := json.Unmarshal(body, &output); err != nil {
+		return nil, fmt.Errorf("HTTP POST (%s): unexpected response (%s): %w", endpoint, response.Status, err)
+	}
+
+	if response.StatusCode != http.StatusOK || output.Error != "" {
+		return nil, fmt.Errorf("HTTP POST (%s): %s: %s", endpoint, response.Status, strings.TrimSpace(output.Error+" "+output.ErrorDescription))
+	}
+
+	if output.AccessToken == "" {
+		return nil, fmt.Errorf("HTTP POST (%s): response did not contain an access token", endpoint)
+	}
+
+	return &output, nil
+}
+
+// accessTokenScopes returns the scopes in the `scope` claim of a Cognito access token.
+func accessTokenScopes(accessToken string) ([]string, error) {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("access token is not a JSON Web Token")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("decoding access token payload: %w", err)
+	}
+
+	var claims struct {
+		Scope string `json:"scope"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("decoding access token payload: %w", err)
+	}
+
+	return strings.Fields(claims.Scope), nil
+}
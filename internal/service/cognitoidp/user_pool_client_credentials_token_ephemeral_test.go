@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCognitoIDPUserPoolClientCredentialsTokenEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoolClientCredentialsTokenEphemeralConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("access_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_at"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_in"), knownvalue.Int64Exact(3600)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("scopes"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("https://" + rName + "/read"),
+						knownvalue.StringExact("https://" + rName + "/write"),
+					})),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("token_type"), knownvalue.StringExact("Bearer")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPUserPoolClientCredentialsTokenEphemeral_scopes(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoolClientCredentialsTokenEphemeralConfig_scopes(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("access_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("scopes"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("https://" + rName + "/read"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccUserPoolClientCredentialsTokenEphemeralConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_cognito_user_pool_domain" "test" {
+  domain       = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+resource "aws_cognito_resource_server" "test" {
+  identifier   = "https://%[1]s"
+  name         = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+
+  scope {
+    scope_name        = "read"
+    scope_description = "Read access"
+  }
+
+  scope {
+    scope_name        = "write"
+    scope_description = "Write access"
+  }
+}
+
+resource "aws_cognito_user_pool_client" "test" {
+  name                                 = %[1]q
+  user_pool_id                         = aws_cognito_user_pool.test.id
+  generate_secret                      = true
+  allowed_oauth_flows                  = ["client_credentials"]
+  allowed_oauth_flows_user_pool_client = true
+  allowed_oauth_scopes                 = aws_cognito_resource_server.test.scope_identifiers
+  supported_identity_providers         = ["COGNITO"]
+
+  depends_on = [aws_cognito_user_pool_domain.test]
+}
+`, rName)
+}
+
+func testAccUserPoolClientCredentialsTokenEphemeralConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cognito_user_pool_client_credentials_token.test"),
+		testAccUserPoolClientCredentialsTokenEphemeralConfig_base(rName),
+		`
+ephemeral "aws_cognito_user_pool_client_credentials_token" "test" {
+  user_pool_id = aws_cognito_user_pool_client.test.user_pool_id
+  client_id    = aws_cognito_user_pool_client.test.id
+}
+`)
+}
+
+func testAccUserPoolClientCredentialsTokenEphemeralConfig_scopes(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cognito_user_pool_client_credentials_token.test"),
+		testAccUserPoolClientCredentialsTokenEphemeralConfig_base(rName),
+		`
+ephemeral "aws_cognito_user_pool_client_credentials_token" "test" {
+  user_pool_id  = aws_cognito_user_pool_client.test.user_pool_id
+  client_id     = aws_cognito_user_pool_client.test.id
+  client_secret = aws_cognito_user_pool_client.test.client_secret
+  domain        = aws_cognito_user_pool_domain.test.domain
+  scopes        = ["${aws_cognito_resource_server.test.identifier}/read"]
+}
+`)
+}
@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_cognito_user_pool_client_secret", name="User Pool Client Secret")
+func newUserPoolClientSecretEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &userPoolClientSecretEphemeralResource{}, nil
+}
+
+type userPoolClientSecretEphemeralResource struct {
+	framework.EphemeralResourceWithModel[userPoolClientSecretEphemeralResourceModel]
+}
+
+func (e *userPoolClientSecretEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrClientID: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrClientSecret: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrUserPoolID: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *userPoolClientSecretEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().CognitoIDPClient(ctx)
+	var data userPoolClientSecretEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	userPoolID, clientID := data.UserPoolID.ValueString(), data.ClientID.ValueString()
+	client, err := findUserPoolClientByTwoPartKey(ctx, conn, userPoolID, clientID)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("reading Cognito User Pool Client (%s): %w", clientID, err), smerr.ID, clientID)
+		return
+	}
+
+	if client.ClientSecret == nil {
+		smerr.AddError(ctx, &response.Diagnostics, errors.New("Cognito User Pool Client has no client secret"), smerr.ID, clientID)
+		return
+	}
+
+	data.ClientSecret = fwflex.StringToFramework(ctx, client.ClientSecret)
+	data.Name = fwflex.StringToFramework(ctx, client.ClientName)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type userPoolClientSecretEphemeralResourceModel struct {
+	framework.WithRegionModel
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Name         types.String `tfsdk:"name"`
+	UserPoolID   types.String `tfsdk:"user_pool_id"`
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sigv4"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+)
+
+const (
+	authTokenActionDBConnect      = "DbConnect"
+	authTokenActionDBConnectAdmin = "DbConnectAdmin"
+	authTokenDefaultExpiresIn     = 15 * time.Minute
+	authTokenMaxExpiresIn         = 7 * 24 * time.Hour
+	authTokenService              = "dsql"
+)
+
+// @EphemeralResource(aws_dsql_auth_token, name="Auth Token")
+func newAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authTokenEphemeralResource{}, nil
+}
+
+type authTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[authTokenEphemeralResourceModel]
+}
+
+func (e *authTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"admin": schema.BoolAttribute{
+				Optional: true,
+			},
+			"expires_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, int64(authTokenMaxExpiresIn/time.Second)),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *authTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data authTokenEphemeralResourceModel
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	action := authTokenActionDBConnect
+	if data.Admin.ValueBool() {
+		action = authTokenActionDBConnectAdmin
+	}
+
+	expiresIn := authTokenDefaultExpiresIn
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = time.Duration(data.ExpiresIn.ValueInt64()) * time.Second
+	}
+
+	hostname := data.Hostname.ValueString()
+	query := url.Values{
+		"Action": []string{action},
+	}
+
+	presignedURL, err := sigv4.PresignURL(ctx, e.Meta().CredentialsProvider(ctx), "https://"+hostname+"/", query, authTokenService, e.Meta().Region(ctx), expiresIn, time.Now())
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("generating Aurora DSQL %s authentication token for %s: %w", action, hostname, err))
+		return
+	}
+
+	data.ExpiresAt = timetypes.NewRFC3339TimeValue(presignedURL.Expiration)
+	data.Token = fwflex.StringValueToFramework(ctx, strings.TrimPrefix(presignedURL.URL, "https://"))
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type authTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Admin     types.Bool        `tfsdk:"admin"`
+	ExpiresAt timetypes.RFC3339 `tfsdk:"expires_at"`
+	ExpiresIn types.Int64       `tfsdk:"expires_in"`
+	Hostname  types.String      `tfsdk:"hostname"`
+	Token     types.String      `tfsdk:"token"`
+}
@@ -0,0 +1,10 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+// Exports for use in other packages.
+var (
+	ExpandTableItemAttributes  = expandTableItemAttributes
+	FlattenTableItemAttributes = flattenTableItemAttributes
+)
@@ -0,0 +1,286 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// forceNewDeploymentPollInterval defines polling cadence for the force new deployment action.
+const forceNewDeploymentPollInterval = 15 * time.Second
+
+// @Action(aws_ecs_force_new_deployment, name="Force New Deployment")
+func newForceNewDeploymentAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &forceNewDeploymentAction{}, nil
+}
+
+var (
+	_ action.Action = (*forceNewDeploymentAction)(nil)
+)
+
+type forceNewDeploymentAction struct {
+	framework.ActionWithModel[forceNewDeploymentModel]
+}
+
+type forceNewDeploymentModel struct {
+	framework.WithRegionModel
+	Cluster            types.String `tfsdk:"cluster"`
+	Service            types.String `tfsdk:"service"`
+	Timeout            types.Int64  `tfsdk:"timeout"`
+	WaitForSteadyState types.Bool   `tfsdk:"wait_for_steady_state"`
+}
+
+func (a *forceNewDeploymentAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a new deployment of an ECS service using the current service definition. By default, this action waits for the deployment to complete.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Name or ARN of the ECS cluster that hosts the service. Defaults to the default cluster.",
+				Optional:    true,
+			},
+			"service": schema.StringAttribute{
+				Description: "Name or ARN of the ECS service to redeploy",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the deployment to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(7200),
+				},
+			},
+			"wait_for_steady_state": schema.BoolAttribute{
+				Description: "Whether to wait for the deployment rollout state to reach COMPLETED (default: true)",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *forceNewDeploymentAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config forceNewDeploymentModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster := fwflex.StringValueFromFramework(ctx, config.Cluster)
+	serviceName := fwflex.StringValueFromFramework(ctx, config.Service)
+	wait := config.WaitForSteadyState.IsNull() || config.WaitForSteadyState.ValueBool()
+
+	// Set default timeout if not provided
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	tflog.Info(ctx, "Starting ECS force new deployment action", map[string]any{
+		"cluster":               cluster,
+		"service":               serviceName,
+		"wait_for_steady_state": wait,
+		names.AttrTimeout:       timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting new deployment for ECS service %s...", serviceName)
+
+	// Check the service exists and is active first
+	service, err := findServiceNoTagsByTwoPartKey(ctx, conn, serviceName, cluster)
+	if retry.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Service Not Found",
+			fmt.Sprintf("ECS service %s was not found", serviceName),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Service",
+			fmt.Sprintf("Could not describe ECS service %s: %s", serviceName, err),
+		)
+		return
+	}
+
+	if status := aws.ToString(service.Status); status != serviceStatusActive {
+		resp.Diagnostics.AddError(
+			"Cannot Deploy Service",
+			fmt.Sprintf("ECS service %s is in status '%s' and cannot be deployed. Service must be in 'ACTIVE' status.", serviceName, status),
+		)
+		return
+	}
+
+	input := ecs.UpdateServiceInput{
+		Cluster:            service.ClusterArn,
+		ForceNewDeployment: true,
+		Service:            service.ServiceArn,
+	}
+
+	output, err := conn.UpdateService(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Force New Deployment",
+			fmt.Sprintf("Could not start a new deployment for ECS service %s: %s", serviceName, err),
+		)
+		return
+	}
+
+	service = output.Service
+	deployment := primaryDeployment(service)
+	if deployment == nil {
+		resp.Diagnostics.AddError(
+			"Failed to Force New Deployment",
+			fmt.Sprintf("UpdateService response for ECS service %s did not contain a primary deployment", serviceName),
+		)
+		return
+	}
+
+	deploymentID := aws.ToString(deployment.Id)
+	cb(ctx, "Deployment %s started for ECS service %s", deploymentID, serviceName)
+
+	if !wait {
+		tflog.Info(ctx, "ECS force new deployment action completed without waiting", map[string]any{
+			"service":       serviceName,
+			"deployment_id": deploymentID,
+		})
+		return
+	}
+
+	// Rollout state is only reported for services that use the ECS deployment controller.
+	if deployment.RolloutState == "" {
+		resp.Diagnostics.AddWarning(
+			"Deployment Rollout State Not Available",
+			fmt.Sprintf("ECS service %s does not report a rollout state for deployment %s, so its completion cannot be awaited. Only services using the ECS deployment controller report rollout states.", serviceName, deploymentID),
+		)
+		return
+	}
+
+	cb(ctx, "Waiting for deployment %s of ECS service %s to complete...", deploymentID, serviceName)
+
+	// Wait for the deployment rollout to complete with periodic progress updates using actionwait
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Deployment], error) {
+		service, derr := findServiceNoTagsByTwoPartKey(ctx, conn, aws.ToString(input.Service), aws.ToString(input.Cluster))
+		if derr != nil {
+			return actionwait.FetchResult[*awstypes.Deployment]{}, fmt.Errorf("describing service: %w", derr)
+		}
+		deployment := findDeploymentByID(service, deploymentID)
+		if deployment == nil {
+			return actionwait.FetchResult[*awstypes.Deployment]{}, fmt.Errorf("deployment %s is no longer present, it may have been superseded by another deployment", deploymentID)
+		}
+		return actionwait.FetchResult[*awstypes.Deployment]{Status: actionwait.Status(deployment.RolloutState), Value: deployment}, nil
+	}, actionwait.Options[*awstypes.Deployment]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(forceNewDeploymentPollInterval),
+		ProgressInterval:   time.Minute,
+		SuccessStates:      []actionwait.Status{actionwait.Status(awstypes.DeploymentRolloutStateCompleted)},
+		TransitionalStates: []actionwait.Status{actionwait.Status(awstypes.DeploymentRolloutStateInProgress)},
+		FailureStates:      []actionwait.Status{actionwait.Status(awstypes.DeploymentRolloutStateFailed)},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if deployment, ok := fr.Value.(*awstypes.Deployment); ok && deployment != nil {
+				cb(ctx, "Deployment %s is %s: %d running, %d pending, %d desired, %d failed tasks", deploymentID, fr.Status, deployment.RunningCount, deployment.PendingCount, deployment.DesiredCount, deployment.FailedTasks)
+				return
+			}
+			cb(ctx, "Deployment %s is currently in state '%s', continuing to wait for 'COMPLETED'...", deploymentID, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Deployment",
+				fmt.Sprintf("Deployment %s of ECS service %s did not complete within %s: %s", deploymentID, serviceName, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Deployment Failed",
+				deploymentFailureDetail(service, fr.Value, serviceName, deploymentID),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Deployment State",
+				fmt.Sprintf("Deployment %s of ECS service %s entered unexpected state: %s", deploymentID, serviceName, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Deployment",
+				fmt.Sprintf("Error while waiting for deployment %s of ECS service %s: %s", deploymentID, serviceName, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	cb(ctx, "Deployment %s of ECS service %s completed successfully", deploymentID, serviceName)
+
+	tflog.Info(ctx, "ECS force new deployment action completed successfully", map[string]any{
+		"service":       serviceName,
+		"deployment_id": deploymentID,
+	})
+}
+
+// primaryDeployment returns the service's PRIMARY deployment, or nil if there is none.
+func primaryDeployment(service *awstypes.Service) *awstypes.Deployment {
+	for i, deployment := range service.Deployments {
+		if aws.ToString(deployment.Status) == "PRIMARY" {
+			return &service.Deployments[i]
+		}
+	}
+
+	return nil
+}
+
+// findDeploymentByID returns the service deployment with the given ID, or nil if there is none.
+func findDeploymentByID(service *awstypes.Service, id string) *awstypes.Deployment {
+	for i, deployment := range service.Deployments {
+		if aws.ToString(deployment.Id) == id {
+			return &service.Deployments[i]
+		}
+	}
+
+	return nil
+}
+
+// deploymentFailureDetail describes a failed deployment, including failed task counts and whether the
+// deployment circuit breaker is rolling the service back to its previous deployment.
+func deploymentFailureDetail(service *awstypes.Service, deployment *awstypes.Deployment, serviceName, deploymentID string) string {
+	detail := fmt.Sprintf("Deployment %s of ECS service %s failed", deploymentID, serviceName)
+	if deployment == nil {
+		return detail
+	}
+
+	detail += fmt.Sprintf(" with %d failed tasks", deployment.FailedTasks)
+	if reason := aws.ToString(deployment.RolloutStateReason); reason != "" {
+		detail += ": " + reason
+	}
+
+	if v := service.DeploymentConfiguration; v != nil && v.DeploymentCircuitBreaker != nil && v.DeploymentCircuitBreaker.Rollback {
+		detail += ". The deployment circuit breaker is rolling the service back to its previous deployment."
+	}
+
+	return detail
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSForceNewDeploymentAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.Service
+	resourceName := "aws_ecs_service.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckServiceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_launchTypeFargateAndWait(rName, 1, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, t, resourceName, &before),
+				),
+			},
+			{
+				Config: testAccForceNewDeploymentActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, t, resourceName, &after),
+					testAccCheckForceNewDeploymentCompleted(&before, &after),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSForceNewDeploymentAction_serviceNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccForceNewDeploymentActionConfig_serviceNotFound(rName),
+				ExpectError: regexache.MustCompile(`Service Not Found`),
+			},
+		},
+	})
+}
+
+func testAccCheckForceNewDeploymentCompleted(before, after *awstypes.Service) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var beforeID string
+		for _, deployment := range before.Deployments {
+			if aws.ToString(deployment.Status) == "PRIMARY" {
+				beforeID = aws.ToString(deployment.Id)
+			}
+		}
+
+		for _, deployment := range after.Deployments {
+			if aws.ToString(deployment.Status) != "PRIMARY" {
+				continue
+			}
+
+			if id := aws.ToString(deployment.Id); id == beforeID {
+				return fmt.Errorf("ECS Service (%s) primary deployment not replaced: %s", aws.ToString(after.ServiceName), id)
+			}
+
+			if deployment.RolloutState != awstypes.DeploymentRolloutStateCompleted {
+				return fmt.Errorf("ECS Service (%s) primary deployment rollout state: %s", aws.ToString(after.ServiceName), deployment.RolloutState)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("ECS Service (%s) has no primary deployment", aws.ToString(after.ServiceName))
+	}
+}
+
+func testAccForceNewDeploymentActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateAndWait(rName, 1, true), `
+action "aws_ecs_force_new_deployment" "test" {
+  config {
+    cluster = aws_ecs_cluster.test.name
+    service = aws_ecs_service.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ecs_force_new_deployment.test]
+    }
+  }
+}
+`)
+}
+
+func testAccForceNewDeploymentActionConfig_serviceNotFound(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+action "aws_ecs_force_new_deployment" "test" {
+  config {
+    cluster = aws_ecs_cluster.test.name
+    service = %[1]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ecs_force_new_deployment.test]
+    }
+  }
+}
+`, rName)
+}
@@ -16,6 +16,7 @@ With the following modifications:
  - Refactor deprecated io/ioutil in Go 1.16
  - Adds context parameter
  - Updated to use the AWS SDK for Go v2
+ - Use the provider's internal/sigv4 presigner instead of an STS presign client with custom middleware
 */
 
 /*
@@ -50,9 +51,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
-	"github.com/aws/smithy-go/middleware"
-	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/sigv4"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -183,79 +184,42 @@ func NewGenerator(forwardSessionName bool, cache bool) (Generator, error) {
 
 // GetWithSTS returns a token valid for clusterID using the given STS client.
 func (g generator) GetWithSTS(ctx context.Context, clusterID string, stsAPI *sts.Client) (Token, error) {
-	// Sign the request.  The expires parameter (sets the x-amz-expires header) is
-	// not supported by the STS presigner.  We set it to 60 [nano]seconds for backwards compatibility (the
-	// parameter was a required argument to AWS SDK v1's Presign(), and authenticators 0.3.0 and older are
-	// expecting a value between 0 and 60 on the server side).
-	presigner := sts.NewPresignClient(stsAPI, func(po *sts.PresignOptions) {
-		po.ClientOptions = []func(*sts.Options){
-			func(o *sts.Options) {
-				o.APIOptions = []func(*middleware.Stack) error{
-					addClusterIdHeaderSetterMiddleware(clusterID),
-					addExpiryParamSetterMiddleware(requestPresignParam),
-				}
-			},
-		}
+	options := stsAPI.Options()
+	endpoint, err := options.EndpointResolverV2.ResolveEndpoint(ctx, sts.EndpointParameters{
+		Region:       aws.String(options.Region),
+		Endpoint:     options.BaseEndpoint,
+		UseDualStack: aws.Bool(options.EndpointOptions.UseDualStackEndpoint == aws.DualStackEndpointStateEnabled),
+		UseFIPS:      aws.Bool(options.EndpointOptions.UseFIPSEndpoint == aws.FIPSEndpointStateEnabled),
 	})
-
-	request, err := presigner.PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
 	if err != nil {
-		return Token{}, err
+		return Token{}, fmt.Errorf("resolving STS endpoint: %w", err)
 	}
 
-	return Token{v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(request.URL))}, nil
-}
-
-func addClusterIdHeaderSetterMiddleware(clusterID string) func(*middleware.Stack) error {
-	return func(stack *middleware.Stack) error {
-		return stack.Build.Add(
-			setClusterIdHeaderMiddleware(clusterID),
-			middleware.After,
-		)
+	u := endpoint.URI
+	if u.Path == "" {
+		u.Path = "/"
 	}
-}
+	u.RawQuery = url.Values{
+		"Action":  []string{"GetCallerIdentity"},
+		"Version": []string{"2011-06-15"},
+	}.Encode()
 
-func setClusterIdHeaderMiddleware(clusterID string) middleware.BuildMiddleware {
-	return middleware.BuildMiddlewareFunc(
-		"Test: Set ClusterId",
-		func(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (out middleware.BuildOutput, metadata middleware.Metadata, err error) {
-			switch req := in.Request.(type) {
-			case *smithyhttp.Request:
-				req.Header.Add(clusterIDHeader, clusterID)
-			default:
-				return out, metadata, fmt.Errorf("unknown transport type %T", in.Request)
-			}
-
-			return next.HandleBuild(ctx, in)
-		},
-	)
-}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return Token{}, err
+	}
+	request.Header.Set(clusterIDHeader, clusterID)
 
-func addExpiryParamSetterMiddleware(expire time.Duration) func(*middleware.Stack) error {
-	return func(stack *middleware.Stack) error {
-		return stack.Build.Add(
-			setExpiryParamMiddleware(expire),
-			middleware.After,
-		)
+	// Sign the request.  The expires parameter (sets the x-amz-expires header) is
+	// not honored by STS.  We set it to 60 [nano]seconds for backwards compatibility (the
+	// parameter was a required argument to AWS SDK v1's Presign(), and authenticators 0.3.0 and older are
+	// expecting a value between 0 and 60 on the server side).
+	presignedURL, err := sigv4.PresignRequest(ctx, options.Credentials, request, "sts", options.Region, requestPresignParam, time.Now())
+	if err != nil {
+		return Token{}, err
 	}
-}
 
-func setExpiryParamMiddleware(expire time.Duration) middleware.BuildMiddleware {
-	return middleware.BuildMiddlewareFunc(
-		"Test: Set ExpiryParam",
-		func(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (out middleware.BuildOutput, metadata middleware.Metadata, err error) {
-			switch req := in.Request.(type) {
-			case *smithyhttp.Request:
-				query := req.URL.Query()
-				query.Set("X-Amz-Expires", strconv.FormatInt(int64(expire/time.Second), 10))
-				req.URL.RawQuery = query.Encode()
-			default:
-				return out, metadata, fmt.Errorf("unknown transport type %T", in.Request)
-			}
-
-			return next.HandleBuild(ctx, in)
-		},
-	)
+	return Token{v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(presignedURL.URL))}, nil
 }
 
 // Verifier validates tokens by calling STS and returning the associated identity.
@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	accessKeyEphemeralResourcePrivateDataKey = "access_key"
+)
+
+// @EphemeralResource("aws_iam_access_key", name="Access Key")
+func newAccessKeyEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &accessKeyEphemeralResource{}, nil
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithClose = &accessKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew = &accessKeyEphemeralResource{}
+)
+
+type accessKeyEphemeralResource struct {
+	framework.EphemeralResourceWithModel[accessKeyEphemeralResourceModel]
+}
+
+func (e *accessKeyEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"create_date": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			"secret": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"ses_smtp_password_v4": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"user": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *accessKeyEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().IAMClient(ctx)
+	var data accessKeyEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	userName := data.User.ValueString()
+	input := iam.CreateAccessKeyInput{
+		UserName: aws.String(userName),
+	}
+
+	output, err := conn.CreateAccessKey(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("creating IAM Access Key (%s): %w", userName, err))
+		return
+	}
+
+	// Close is not called if Open fails, so delete the new key here if anything after this point fails.
+	defer func() {
+		if !response.Diagnostics.HasError() || output.AccessKey == nil {
+			return
+		}
+
+		accessKeyID := aws.ToString(output.AccessKey.AccessKeyId)
+		input := iam.DeleteAccessKeyInput{
+			AccessKeyId: aws.String(accessKeyID),
+			UserName:    aws.String(userName),
+		}
+		_, err := conn.DeleteAccessKey(ctx, &input)
+
+		if errs.IsA[*awstypes.NoSuchEntityException](err) {
+			return
+		}
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("deleting IAM Access Key (%s): %w", accessKeyID, err), smerr.ID, accessKeyID)
+		}
+	}()
+
+	if output.AccessKey == nil || output.AccessKey.SecretAccessKey == nil {
+		smerr.AddError(ctx, &response.Diagnostics, errors.New("CreateAccessKey response did not contain a Secret Access Key as expected"))
+		return
+	}
+
+	accessKey := output.AccessKey
+	accessKeyID := aws.ToString(accessKey.AccessKeyId)
+
+	// Record the key so that Close deletes it.
+	privateData, err := json.Marshal(accessKeyEphemeralResourcePrivateData{
+		AccessKeyID: accessKeyID,
+		UserName:    userName,
+	})
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accessKeyID)
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Private.SetKey(ctx, accessKeyEphemeralResourcePrivateDataKey, privateData))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	sesSMTPPasswordV4, err := sesSMTPPasswordFromSecretKeySigV4(accessKey.SecretAccessKey, e.Meta().Region(ctx))
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("getting SES SigV4 SMTP Password from Secret Access Key: %w", err), smerr.ID, accessKeyID)
+		return
+	}
+
+	data.CreateDate = timetypes.NewRFC3339TimePointerValue(accessKey.CreateDate)
+	data.ID = types.StringValue(accessKeyID)
+	data.Secret = fwflex.StringToFramework(ctx, accessKey.SecretAccessKey)
+	data.SESSMTPPasswordV4 = types.StringValue(sesSMTPPasswordV4)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+// Renew is a no-op. The access key remains valid until it is deleted on Close.
+func (e *accessKeyEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+}
+
+func (e *accessKeyEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	conn := e.Meta().IAMClient(ctx)
+
+	v, diags := request.Private.GetKey(ctx, accessKeyEphemeralResourcePrivateDataKey)
+	smerr.AddEnrich(ctx, &response.Diagnostics, diags)
+	if response.Diagnostics.HasError() || v == nil {
+		return
+	}
+
+	var privateData accessKeyEphemeralResourcePrivateData
+	if err := json.Unmarshal(v, &privateData); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	input := iam.DeleteAccessKeyInput{
+		AccessKeyId: aws.String(privateData.AccessKeyID),
+		UserName:    aws.String(privateData.UserName),
+	}
+	_, err := conn.DeleteAccessKey(ctx, &input)
+
+	if errs.IsA[*awstypes.NoSuchEntityException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("deleting IAM Access Key (%s): %w", privateData.AccessKeyID, err), smerr.ID, privateData.AccessKeyID)
+		return
+	}
+}
+
+type accessKeyEphemeralResourceModel struct {
+	CreateDate        timetypes.RFC3339 `tfsdk:"create_date"`
+	ID                types.String      `tfsdk:"id"`
+	Secret            types.String      `tfsdk:"secret"`
+	SESSMTPPasswordV4 types.String      `tfsdk:"ses_smtp_password_v4"`
+	User              types.String      `tfsdk:"user"`
+}
+
+type accessKeyEphemeralResourcePrivateData struct {
+	AccessKeyID string `json:"access_key_id"`
+	UserName    string `json:"user_name"`
+}
@@ -17,4 +17,6 @@ type (
 	IAMPolicyStatement             = iamPolicyStatement
 	IAMPolicyStatementPrincipal    = iamPolicyStatementPrincipal
 	IAMPolicyStatementPrincipalSet = iamPolicyStatementPrincipalSet
+	IAMPolicyStatementCondition    = iamPolicyStatementCondition
+	IAMPolicyStatementConditionSet = iamPolicyStatementConditionSet
 )
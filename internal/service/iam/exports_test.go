@@ -34,6 +34,7 @@ var (
 	ResourceVirtualMFADevice              = resourceVirtualMFADevice
 
 	FindAccessKeyByTwoPartKey                   = findAccessKeyByTwoPartKey
+	FindAccessKeysByUser                        = findAccessKeysByUser
 	FindAccountAlias                            = findAccountAlias
 	FindAccountPasswordPolicy                   = findAccountPasswordPolicy
 	FindAttachedGroupPolicies                   = findAttachedGroupPolicies
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kafka
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/sigv4"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+)
+
+const (
+	// iamAuthTokenExpiration is the lifetime of an MSK IAM authentication token.
+	// See https://github.com/aws/aws-msk-iam-sasl-signer-go.
+	iamAuthTokenExpiration         = 15 * time.Minute
+	iamAuthTokenDefaultSessionName = "MSKSASLDefaultSession"
+	iamAuthTokenService            = "kafka-cluster"
+	iamAuthTokenUserAgent          = "terraform-provider-aws"
+)
+
+// @EphemeralResource(aws_msk_iam_auth_token, name="IAM Auth Token")
+func newIAMAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &iamAuthTokenEphemeralResource{}, nil
+}
+
+type iamAuthTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[iamAuthTokenEphemeralResourceModel]
+}
+
+func (e *iamAuthTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expires_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"role_session_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("role_arn")),
+					stringvalidator.LengthBetween(2, 64),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *iamAuthTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data iamAuthTokenEphemeralResourceModel
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	credentialsProvider := e.Meta().CredentialsProvider(ctx)
+	if roleARN := data.RoleARN.ValueString(); roleARN != "" {
+		sessionName := iamAuthTokenDefaultSessionName
+		if !data.RoleSessionName.IsNull() {
+			sessionName = data.RoleSessionName.ValueString()
+		}
+		credentialsProvider = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(e.Meta().STSClient(ctx), roleARN, func(o *stscreds.AssumeRoleOptions) {
+			o.RoleSessionName = sessionName
+		}))
+	}
+
+	query := url.Values{
+		"Action": []string{"kafka-cluster:Connect"},
+	}
+
+	presignedURL, err := sigv4.PresignURL(ctx, credentialsProvider, "https://"+e.Meta().RegionalHostname(ctx, "kafka")+"/", query, iamAuthTokenService, e.Meta().Region(ctx), iamAuthTokenExpiration, time.Now())
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("generating MSK IAM authentication token: %w", err))
+		return
+	}
+
+	// The User-Agent query parameter is added after signing.
+	u, err := url.Parse(presignedURL.URL)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+	values := u.Query()
+	values.Set("User-Agent", iamAuthTokenUserAgent)
+	u.RawQuery = values.Encode()
+
+	data.ExpiresAt = timetypes.NewRFC3339TimeValue(presignedURL.Expiration)
+	data.Token = fwflex.StringValueToFramework(ctx, base64.RawURLEncoding.EncodeToString([]byte(u.String())))
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type iamAuthTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	ExpiresAt       timetypes.RFC3339 `tfsdk:"expires_at"`
+	RoleARN         fwtypes.ARN       `tfsdk:"role_arn"`
+	RoleSessionName types.String      `tfsdk:"role_session_name"`
+	Token           types.String      `tfsdk:"token"`
+}
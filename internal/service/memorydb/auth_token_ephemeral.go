@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package memorydb
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sigv4"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// authTokenExpiration is the lifetime of an IAM authentication token.
+	// See https://docs.aws.amazon.com/memorydb/latest/devguide/auth-iam.html.
+	authTokenExpiration = 15 * time.Minute
+	authTokenService    = "memorydb"
+)
+
+// @EphemeralResource(aws_memorydb_auth_token, name="Auth Token")
+func newAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authTokenEphemeralResource{}, nil
+}
+
+type authTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[authTokenEphemeralResourceModel]
+}
+
+func (e *authTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrClusterName: schema.StringAttribute{
+				Required: true,
+			},
+			"expires_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *authTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data authTokenEphemeralResourceModel
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	clusterName, userName := data.ClusterName.ValueString(), data.UserName.ValueString()
+	query := url.Values{
+		"Action": []string{"connect"},
+		"User":   []string{userName},
+	}
+
+	presignedURL, err := sigv4.PresignURL(ctx, e.Meta().CredentialsProvider(ctx), "https://"+clusterName+"/", query, authTokenService, e.Meta().Region(ctx), authTokenExpiration, time.Now())
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("generating MemoryDB authentication token for %s@%s: %w", userName, clusterName, err))
+		return
+	}
+
+	data.ExpiresAt = timetypes.NewRFC3339TimeValue(presignedURL.Expiration)
+	data.Token = fwflex.StringValueToFramework(ctx, strings.TrimPrefix(presignedURL.URL, "https://"))
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type authTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	ClusterName types.String      `tfsdk:"cluster_name"`
+	ExpiresAt   timetypes.RFC3339 `tfsdk:"expires_at"`
+	Token       types.String      `tfsdk:"token"`
+	UserName    types.String      `tfsdk:"user_name"`
+}
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rds_create_db_cluster_snapshot, name="Create DB Cluster Snapshot")
+func newCreateDBClusterSnapshotAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createDBClusterSnapshotAction{}, nil
+}
+
+var (
+	_ action.Action = (*createDBClusterSnapshotAction)(nil)
+)
+
+type createDBClusterSnapshotAction struct {
+	framework.ActionWithModel[createDBClusterSnapshotModel]
+}
+
+type createDBClusterSnapshotModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier         types.String `tfsdk:"db_cluster_identifier"`
+	DBClusterSnapshotIdentifier types.String `tfsdk:"db_cluster_snapshot_identifier"`
+	Timeout                     types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *createDBClusterSnapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a manual snapshot of an RDS DB cluster and waits for the snapshot to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_cluster_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB cluster to snapshot",
+				Required:    true,
+			},
+			"db_cluster_snapshot_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB cluster snapshot to create",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the snapshot to become available (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *createDBClusterSnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createDBClusterSnapshotModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().RDSClient(ctx)
+
+	clusterID := fwflex.StringValueFromFramework(ctx, config.DBClusterIdentifier)
+	snapshotID := fwflex.StringValueFromFramework(ctx, config.DBClusterSnapshotIdentifier)
+
+	// Set default timeout if not provided
+	timeout := fwactions.TimeoutOr(config.Timeout, 60*time.Minute)
+
+	tflog.Info(ctx, "Starting RDS create DB cluster snapshot action", map[string]any{
+		"db_cluster_identifier":          clusterID,
+		"db_cluster_snapshot_identifier": snapshotID,
+		names.AttrTimeout:                timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Creating snapshot %s of RDS DB cluster %s...", snapshotID, clusterID)
+
+	input := rds.CreateDBClusterSnapshotInput{
+		DBClusterIdentifier:         aws.String(clusterID),
+		DBClusterSnapshotIdentifier: aws.String(snapshotID),
+	}
+
+	// The cluster briefly rejects snapshot requests while a previous operation settles.
+	const (
+		createTimeout = 2 * time.Minute
+	)
+	_, err := tfresource.RetryWhenIsA[any, *awstypes.InvalidDBClusterStateFault](ctx, createTimeout, func(ctx context.Context) (any, error) {
+		return conn.CreateDBClusterSnapshot(ctx, &input)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create DB Cluster Snapshot",
+			fmt.Sprintf("Could not create snapshot %s of RDS DB cluster %s: %s", snapshotID, clusterID, err),
+		)
+		return
+	}
+
+	cb(ctx, "Waiting for snapshot %s to become available...", snapshotID)
+
+	snapshot, err := waitDBClusterSnapshotCreated(ctx, conn, snapshotID, timeout)
+	if err != nil {
+		if retry.TimedOut(err) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Cluster Snapshot",
+				fmt.Sprintf("Snapshot %s of RDS DB cluster %s did not become available within %s: %s", snapshotID, clusterID, timeout, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for DB Cluster Snapshot",
+				fmt.Sprintf("Error while waiting for snapshot %s of RDS DB cluster %s: %s", snapshotID, clusterID, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	cb(ctx, "Snapshot %s of RDS DB cluster %s is available (%s)", snapshotID, clusterID, aws.ToString(snapshot.DBClusterSnapshotArn))
+
+	tflog.Info(ctx, "RDS create DB cluster snapshot action completed successfully", map[string]any{
+		"db_cluster_identifier":          clusterID,
+		"db_cluster_snapshot_identifier": snapshotID,
+		"db_cluster_snapshot_arn":        aws.ToString(snapshot.DBClusterSnapshotArn),
+	})
+}
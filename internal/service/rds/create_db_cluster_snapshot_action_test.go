@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSCreateDBClusterSnapshotAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckCreateDBClusterSnapshotActionSnapshotDelete(ctx, t, rName),
+			testAccCheckClusterDestroy(ctx, t),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateDBClusterSnapshotActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCreateDBClusterSnapshotActionSnapshotAvailable(ctx, t, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCreateDBClusterSnapshotAction_clusterNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCreateDBClusterSnapshotActionConfig_clusterNotFound(rName),
+				ExpectError: regexache.MustCompile(`Failed to Create DB Cluster Snapshot`),
+			},
+		},
+	})
+}
+
+func testAccCheckCreateDBClusterSnapshotActionSnapshotAvailable(ctx context.Context, t *testing.T, id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+		output, err := tfrds.FindDBClusterSnapshotByID(ctx, conn, id)
+		if err != nil {
+			return err
+		}
+
+		if status := aws.ToString(output.Status); status != "available" {
+			return fmt.Errorf("RDS DB Cluster Snapshot (%s) status: %s", id, status)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckCreateDBClusterSnapshotActionSnapshotDelete deletes the snapshot created by the action,
+// which is not managed by Terraform and so is not removed on destroy.
+func testAccCheckCreateDBClusterSnapshotActionSnapshotDelete(ctx context.Context, t *testing.T, id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+		_, err := conn.DeleteDBClusterSnapshot(ctx, &rds.DeleteDBClusterSnapshotInput{
+			DBClusterSnapshotIdentifier: aws.String(id),
+		})
+
+		if errs.IsA[*types.DBClusterSnapshotNotFoundFault](err) {
+			return nil
+		}
+
+		return err
+	}
+}
+
+func testAccCreateDBClusterSnapshotActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterSnapshotConfig_base(rName), `
+action "aws_rds_create_db_cluster_snapshot" "test" {
+  config {
+    db_cluster_identifier          = aws_rds_cluster.test.id
+    db_cluster_snapshot_identifier = aws_rds_cluster.test.id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_rds_create_db_cluster_snapshot.test]
+    }
+  }
+}
+`)
+}
+
+func testAccCreateDBClusterSnapshotActionConfig_clusterNotFound(rName string) string {
+	return fmt.Sprintf(`
+action "aws_rds_create_db_cluster_snapshot" "test" {
+  config {
+    db_cluster_identifier          = %[1]q
+    db_cluster_snapshot_identifier = %[1]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_rds_create_db_cluster_snapshot.test]
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rds_create_db_snapshot, name="Create DB Snapshot")
+func newCreateDBSnapshotAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createDBSnapshotAction{}, nil
+}
+
+var (
+	_ action.Action = (*createDBSnapshotAction)(nil)
+)
+
+type createDBSnapshotAction struct {
+	framework.ActionWithModel[createDBSnapshotModel]
+}
+
+type createDBSnapshotModel struct {
+	framework.WithRegionModel
+	DBInstanceIdentifier types.String `tfsdk:"db_instance_identifier"`
+	DBSnapshotIdentifier types.String `tfsdk:"db_snapshot_identifier"`
+	Timeout              types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *createDBSnapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a manual snapshot of an RDS DB instance and waits for the snapshot to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_instance_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB instance to snapshot",
+				Required:    true,
+			},
+			"db_snapshot_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB snapshot to create",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the snapshot to become available (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *createDBSnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createDBSnapshotModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().RDSClient(ctx)
+
+	instanceID := fwflex.StringValueFromFramework(ctx, config.DBInstanceIdentifier)
+	snapshotID := fwflex.StringValueFromFramework(ctx, config.DBSnapshotIdentifier)
+
+	// Set default timeout if not provided
+	timeout := fwactions.TimeoutOr(config.Timeout, 60*time.Minute)
+
+	tflog.Info(ctx, "Starting RDS create DB snapshot action", map[string]any{
+		"db_instance_identifier": instanceID,
+		"db_snapshot_identifier": snapshotID,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Creating snapshot %s of RDS DB instance %s...", snapshotID, instanceID)
+
+	input := rds.CreateDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(instanceID),
+		DBSnapshotIdentifier: aws.String(snapshotID),
+	}
+
+	if _, err := conn.CreateDBSnapshot(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create DB Snapshot",
+			fmt.Sprintf("Could not create snapshot %s of RDS DB instance %s: %s", snapshotID, instanceID, err),
+		)
+		return
+	}
+
+	cb(ctx, "Waiting for snapshot %s to become available...", snapshotID)
+
+	snapshot, err := waitDBSnapshotCreated(ctx, conn, snapshotID, timeout)
+	if err != nil {
+		if retry.TimedOut(err) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Snapshot",
+				fmt.Sprintf("Snapshot %s of RDS DB instance %s did not become available within %s: %s", snapshotID, instanceID, timeout, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for DB Snapshot",
+				fmt.Sprintf("Error while waiting for snapshot %s of RDS DB instance %s: %s", snapshotID, instanceID, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	cb(ctx, "Snapshot %s of RDS DB instance %s is available (%s)", snapshotID, instanceID, aws.ToString(snapshot.DBSnapshotArn))
+
+	tflog.Info(ctx, "RDS create DB snapshot action completed successfully", map[string]any{
+		"db_instance_identifier": instanceID,
+		"db_snapshot_identifier": snapshotID,
+		"db_snapshot_arn":        aws.ToString(snapshot.DBSnapshotArn),
+	})
+}
@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSCreateDBSnapshotAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckCreateDBSnapshotActionSnapshotDelete(ctx, t, rName),
+			testAccCheckDBInstanceDestroy(ctx, t),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateDBSnapshotActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCreateDBSnapshotActionSnapshotAvailable(ctx, t, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCreateDBSnapshotAction_instanceNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCreateDBSnapshotActionConfig_instanceNotFound(rName),
+				ExpectError: regexache.MustCompile(`Failed to Create DB Snapshot`),
+			},
+		},
+	})
+}
+
+func testAccCheckCreateDBSnapshotActionSnapshotAvailable(ctx context.Context, t *testing.T, id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+		output, err := tfrds.FindDBSnapshotByID(ctx, conn, id)
+		if err != nil {
+			return err
+		}
+
+		if status := aws.ToString(output.Status); status != "available" {
+			return fmt.Errorf("RDS DB Snapshot (%s) status: %s", id, status)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckCreateDBSnapshotActionSnapshotDelete deletes the snapshot created by the action,
+// which is not managed by Terraform and so is not removed on destroy.
+func testAccCheckCreateDBSnapshotActionSnapshotDelete(ctx context.Context, t *testing.T, id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+		_, err := conn.DeleteDBSnapshot(ctx, &rds.DeleteDBSnapshotInput{
+			DBSnapshotIdentifier: aws.String(id),
+		})
+
+		if errs.IsA[*types.DBSnapshotNotFoundFault](err) {
+			return nil
+		}
+
+		return err
+	}
+}
+
+func testAccCreateDBSnapshotActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSnapshotConfig_base(rName), `
+action "aws_rds_create_db_snapshot" "test" {
+  config {
+    db_instance_identifier = aws_db_instance.test.identifier
+    db_snapshot_identifier = aws_db_instance.test.identifier
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_rds_create_db_snapshot.test]
+    }
+  }
+}
+`)
+}
+
+func testAccCreateDBSnapshotActionConfig_instanceNotFound(rName string) string {
+	return fmt.Sprintf(`
+action "aws_rds_create_db_snapshot" "test" {
+  config {
+    db_instance_identifier = %[1]q
+    db_snapshot_identifier = %[1]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_rds_create_db_snapshot.test]
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rds_failover_db_cluster, name="Failover DB Cluster")
+func newFailoverDBClusterAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &failoverDBClusterAction{}, nil
+}
+
+var (
+	_ action.Action = (*failoverDBClusterAction)(nil)
+)
+
+type failoverDBClusterAction struct {
+	framework.ActionWithModel[failoverDBClusterModel]
+}
+
+type failoverDBClusterModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier        types.String `tfsdk:"db_cluster_identifier"`
+	TargetDBInstanceIdentifier types.String `tfsdk:"target_db_instance_identifier"`
+	Timeout                    types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *failoverDBClusterAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a failover of an RDS DB cluster, promoting a reader instance to the writer, and waits for the cluster's instances to return to the available state.",
+		Attributes: map[string]schema.Attribute{
+			"db_cluster_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB cluster to fail over",
+				Required:    true,
+			},
+			"target_db_instance_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB instance to promote to the writer. Defaults to a reader chosen by RDS.",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the cluster's instances to become available (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(7200),
+				},
+			},
+		},
+	}
+}
+
+func (a *failoverDBClusterAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config failoverDBClusterModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().RDSClient(ctx)
+
+	clusterID := fwflex.StringValueFromFramework(ctx, config.DBClusterIdentifier)
+	targetInstanceID := fwflex.StringValueFromFramework(ctx, config.TargetDBInstanceIdentifier)
+
+	// Set default timeout if not provided
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	tflog.Info(ctx, "Starting RDS failover DB cluster action", map[string]any{
+		"db_cluster_identifier":         clusterID,
+		"target_db_instance_identifier": targetInstanceID,
+		names.AttrTimeout:               timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting failover of RDS DB cluster %s...", clusterID)
+
+	input := rds.FailoverDBClusterInput{
+		DBClusterIdentifier: aws.String(clusterID),
+	}
+	if targetInstanceID != "" {
+		input.TargetDBInstanceIdentifier = aws.String(targetInstanceID)
+	}
+
+	output, err := conn.FailoverDBCluster(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Failover DB Cluster",
+			fmt.Sprintf("Could not fail over RDS DB cluster %s: %s", clusterID, err),
+		)
+		return
+	}
+
+	if output.DBCluster == nil {
+		resp.Diagnostics.AddError(
+			"Failed to Failover DB Cluster",
+			fmt.Sprintf("Failover of RDS DB cluster %s returned no cluster", clusterID),
+		)
+		return
+	}
+
+	// All instances share a single deadline so that large clusters still honor the configured timeout.
+	deadline := time.Now().Add(timeout)
+	for _, member := range output.DBCluster.DBClusterMembers {
+		instanceID := aws.ToString(member.DBInstanceIdentifier)
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Cluster Failover",
+				fmt.Sprintf("RDS DB instance %s of cluster %s did not become available within %s", instanceID, clusterID, timeout),
+			)
+			return
+		}
+
+		cb(ctx, "Waiting for RDS DB instance %s to become available...", instanceID)
+
+		if _, err := waitDBInstanceAvailable(ctx, conn, instanceID, remaining); err != nil {
+			if retry.TimedOut(err) {
+				resp.Diagnostics.AddError(
+					"Timeout Waiting for DB Cluster Failover",
+					fmt.Sprintf("RDS DB instance %s of cluster %s did not become available within %s: %s", instanceID, clusterID, timeout, err),
+				)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error Waiting for DB Cluster Failover",
+					fmt.Sprintf("Error while waiting for RDS DB instance %s of cluster %s to become available: %s", instanceID, clusterID, err),
+				)
+			}
+			return
+		}
+	}
+
+	cluster, err := findDBClusterByID(ctx, conn, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe DB Cluster",
+			fmt.Sprintf("Could not describe RDS DB cluster %s after failover: %s", clusterID, err),
+		)
+		return
+	}
+
+	// Final success message
+	cb(ctx, "Failover of RDS DB cluster %s completed successfully, writer instance is %s", clusterID, clusterWriterInstanceID(cluster))
+
+	tflog.Info(ctx, "RDS failover DB cluster action completed successfully", map[string]any{
+		"db_cluster_identifier": clusterID,
+		"writer_instance":       clusterWriterInstanceID(cluster),
+	})
+}
+
+// clusterWriterInstanceID returns the identifier of the cluster's writer instance, or an empty string if there is none.
+func clusterWriterInstanceID(cluster *awstypes.DBCluster) string {
+	for _, member := range cluster.DBClusterMembers {
+		if aws.ToBool(member.IsClusterWriter) {
+			return aws.ToString(member.DBInstanceIdentifier)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rds_reboot_db_instance, name="Reboot DB Instance")
+func newRebootDBInstanceAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &rebootDBInstanceAction{}, nil
+}
+
+var (
+	_ action.Action = (*rebootDBInstanceAction)(nil)
+)
+
+type rebootDBInstanceAction struct {
+	framework.ActionWithModel[rebootDBInstanceModel]
+}
+
+type rebootDBInstanceModel struct {
+	framework.WithRegionModel
+	DBInstanceIdentifier types.String `tfsdk:"db_instance_identifier"`
+	ForceFailover        types.Bool   `tfsdk:"force_failover"`
+	Timeout              types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rebootDBInstanceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reboots an RDS DB instance and waits for it to return to the available state. Rebooting applies pending changes to the instance's DB parameter group.",
+		Attributes: map[string]schema.Attribute{
+			"db_instance_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB instance to reboot",
+				Required:    true,
+			},
+			"force_failover": schema.BoolAttribute{
+				Description: "Whether the reboot is conducted through a Multi-AZ failover. Only valid for instances configured for Multi-AZ.",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the instance to become available (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(7200),
+				},
+			},
+		},
+	}
+}
+
+func (a *rebootDBInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rebootDBInstanceModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().RDSClient(ctx)
+
+	instanceID := fwflex.StringValueFromFramework(ctx, config.DBInstanceIdentifier)
+	forceFailover := config.ForceFailover.ValueBool()
+
+	// Set default timeout if not provided
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	tflog.Info(ctx, "Starting RDS reboot DB instance action", map[string]any{
+		"db_instance_identifier": instanceID,
+		"force_failover":         forceFailover,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Rebooting RDS DB instance %s...", instanceID)
+
+	input := rds.RebootDBInstanceInput{
+		DBInstanceIdentifier: aws.String(instanceID),
+	}
+	if forceFailover {
+		input.ForceFailover = aws.Bool(true)
+	}
+
+	if _, err := conn.RebootDBInstance(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Reboot DB Instance",
+			fmt.Sprintf("Could not reboot RDS DB instance %s: %s", instanceID, err),
+		)
+		return
+	}
+
+	cb(ctx, "Waiting for RDS DB instance %s to become available...", instanceID)
+
+	if _, err := waitDBInstanceAvailable(ctx, conn, instanceID, timeout); err != nil {
+		if retry.TimedOut(err) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Instance",
+				fmt.Sprintf("RDS DB instance %s did not become available within %s: %s", instanceID, timeout, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for DB Instance",
+				fmt.Sprintf("Error while waiting for RDS DB instance %s to become available: %s", instanceID, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	cb(ctx, "RDS DB instance %s rebooted successfully and is available", instanceID)
+
+	tflog.Info(ctx, "RDS reboot DB instance action completed successfully", map[string]any{
+		"db_instance_identifier": instanceID,
+	})
+}
@@ -15,3 +15,19 @@ const (
 	journalTableConfigurationStatusCreating = "CREATING"
 	journalTableConfigurationStatusFailed   = "FAILED"
 )
+
+type presignedURLOperation string
+
+const (
+	presignedURLOperationGetObject  presignedURLOperation = "GetObject"
+	presignedURLOperationHeadObject presignedURLOperation = "HeadObject"
+	presignedURLOperationPutObject  presignedURLOperation = "PutObject"
+)
+
+func (presignedURLOperation) Values() []presignedURLOperation {
+	return []presignedURLOperation{
+		presignedURLOperationGetObject,
+		presignedURLOperationHeadObject,
+		presignedURLOperationPutObject,
+	}
+}
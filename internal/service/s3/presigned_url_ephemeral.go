@@ -0,0 +1,252 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	presignedURLDefaultExpiresIn = 900    // 15 minutes.
+	presignedURLMaxExpiresIn     = 604800 // 7 days.
+)
+
+// @EphemeralResource("aws_s3_presigned_url", name="Presigned URL")
+func newPresignedURLEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &presignedURLEphemeralResource{}, nil
+}
+
+type presignedURLEphemeralResource struct {
+	framework.EphemeralResourceWithModel[presignedURLEphemeralResourceModel]
+}
+
+func (e *presignedURLEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+			},
+			"checksum_algorithm": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ChecksumAlgorithm](),
+				Optional:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("checksum_value")),
+				},
+			},
+			"checksum_value": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("checksum_algorithm")),
+				},
+			},
+			names.AttrContentType: schema.StringAttribute{
+				Optional: true,
+			},
+			"customer_algorithm": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("customer_key")),
+				},
+			},
+			"customer_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("customer_algorithm")),
+				},
+			},
+			"customer_key_md5": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("customer_key")),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, presignedURLMaxExpiresIn),
+				},
+			},
+			names.AttrKey: schema.StringAttribute{
+				Required: true,
+			},
+			"method": schema.StringAttribute{
+				Computed: true,
+			},
+			"operation": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[presignedURLOperation](),
+				Optional:   true,
+			},
+			"signed_headers": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Computed:   true,
+				Sensitive:  true,
+			},
+			names.AttrURL: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"version_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (e *presignedURLEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data presignedURLEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket := data.Bucket.ValueString()
+	conn := e.Meta().S3Client(ctx)
+	if isDirectoryBucket(bucket) {
+		conn = e.Meta().S3ExpressClient(ctx)
+	}
+
+	operation := presignedURLOperationGetObject
+	if !data.Operation.IsNull() {
+		operation = data.Operation.ValueEnum()
+	}
+
+	expiresIn := time.Duration(presignedURLDefaultExpiresIn) * time.Second
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = time.Duration(data.ExpiresIn.ValueInt64()) * time.Second
+	}
+
+	switch {
+	case operation != presignedURLOperationPutObject && !data.ChecksumAlgorithm.IsNull():
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("checksum_algorithm is not supported for the %s operation", operation))
+		return
+	case operation == presignedURLOperationHeadObject && !data.ContentType.IsNull():
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("content_type is not supported for the %s operation", operation))
+		return
+	case operation == presignedURLOperationPutObject && !data.VersionID.IsNull():
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("version_id is not supported for the %s operation", operation))
+		return
+	}
+
+	presignClient := s3.NewPresignClient(conn, s3.WithPresignExpires(expiresIn))
+	signingTime := time.Now()
+
+	var output *v4.PresignedHTTPRequest
+	var err error
+	switch operation {
+	case presignedURLOperationGetObject:
+		input := s3.GetObjectInput{
+			Bucket:               aws.String(bucket),
+			Key:                  fwflex.StringFromFramework(ctx, data.Key),
+			ResponseContentType:  fwflex.StringFromFramework(ctx, data.ContentType),
+			SSECustomerAlgorithm: fwflex.StringFromFramework(ctx, data.CustomerAlgorithm),
+			SSECustomerKey:       fwflex.StringFromFramework(ctx, data.CustomerKey),
+			SSECustomerKeyMD5:    fwflex.StringFromFramework(ctx, data.CustomerKeyMD5),
+			VersionId:            fwflex.StringFromFramework(ctx, data.VersionID),
+		}
+
+		output, err = presignClient.PresignGetObject(ctx, &input)
+	case presignedURLOperationHeadObject:
+		input := s3.HeadObjectInput{
+			Bucket:               aws.String(bucket),
+			Key:                  fwflex.StringFromFramework(ctx, data.Key),
+			SSECustomerAlgorithm: fwflex.StringFromFramework(ctx, data.CustomerAlgorithm),
+			SSECustomerKey:       fwflex.StringFromFramework(ctx, data.CustomerKey),
+			SSECustomerKeyMD5:    fwflex.StringFromFramework(ctx, data.CustomerKeyMD5),
+			VersionId:            fwflex.StringFromFramework(ctx, data.VersionID),
+		}
+
+		output, err = presignClient.PresignHeadObject(ctx, &input)
+	case presignedURLOperationPutObject:
+		input := s3.PutObjectInput{
+			Bucket:               aws.String(bucket),
+			ContentType:          fwflex.StringFromFramework(ctx, data.ContentType),
+			Key:                  fwflex.StringFromFramework(ctx, data.Key),
+			SSECustomerAlgorithm: fwflex.StringFromFramework(ctx, data.CustomerAlgorithm),
+			SSECustomerKey:       fwflex.StringFromFramework(ctx, data.CustomerKey),
+			SSECustomerKeyMD5:    fwflex.StringFromFramework(ctx, data.CustomerKeyMD5),
+		}
+
+		if !data.ChecksumAlgorithm.IsNull() {
+			checksum := fwflex.StringFromFramework(ctx, data.ChecksumValue)
+			input.ChecksumAlgorithm = data.ChecksumAlgorithm.ValueEnum()
+			switch input.ChecksumAlgorithm {
+			case awstypes.ChecksumAlgorithmCrc32:
+				input.ChecksumCRC32 = checksum
+			case awstypes.ChecksumAlgorithmCrc32c:
+				input.ChecksumCRC32C = checksum
+			case awstypes.ChecksumAlgorithmCrc64nvme:
+				input.ChecksumCRC64NVME = checksum
+			case awstypes.ChecksumAlgorithmSha1:
+				input.ChecksumSHA1 = checksum
+			case awstypes.ChecksumAlgorithmSha256:
+				input.ChecksumSHA256 = checksum
+			}
+		}
+
+		output, err = presignClient.PresignPutObject(ctx, &input)
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("presigning S3 %s request: %w", operation, err), smerr.ID, bucket)
+		return
+	}
+
+	signedHeaders := make(map[string]attr.Value, len(output.SignedHeader))
+	for k, v := range output.SignedHeader {
+		signedHeaders[k] = types.StringValue(strings.Join(v, ","))
+	}
+
+	data.ExpiresAt = timetypes.NewRFC3339TimeValue(signingTime.UTC().Truncate(time.Second).Add(expiresIn))
+	data.Method = fwflex.StringValueToFramework(ctx, output.Method)
+	data.SignedHeaders = fwtypes.NewMapValueOfMust[types.String](ctx, signedHeaders)
+	data.URL = fwflex.StringValueToFramework(ctx, output.URL)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type presignedURLEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Bucket            types.String                                   `tfsdk:"bucket"`
+	ChecksumAlgorithm fwtypes.StringEnum[awstypes.ChecksumAlgorithm] `tfsdk:"checksum_algorithm"`
+	ChecksumValue     types.String                                   `tfsdk:"checksum_value"`
+	ContentType       types.String                                   `tfsdk:"content_type"`
+	CustomerAlgorithm types.String                                   `tfsdk:"customer_algorithm"`
+	CustomerKey       types.String                                   `tfsdk:"customer_key"`
+	CustomerKeyMD5    types.String                                   `tfsdk:"customer_key_md5"`
+	ExpiresAt         timetypes.RFC3339                              `tfsdk:"expires_at"`
+	ExpiresIn         types.Int64                                    `tfsdk:"expires_in"`
+	Key               types.String                                   `tfsdk:"key"`
+	Method            types.String                                   `tfsdk:"method"`
+	Operation         fwtypes.StringEnum[presignedURLOperation]      `tfsdk:"operation"`
+	SignedHeaders     fwtypes.MapOfString                            `tfsdk:"signed_headers"`
+	URL               types.String                                   `tfsdk:"url"`
+	VersionID         types.String                                   `tfsdk:"version_id"`
+}
@@ -0,0 +1,431 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// sendCommandPollInterval defines polling cadence for the send command action.
+	sendCommandPollInterval = 10 * time.Second
+	// sendCommandOutputMaxLength is the maximum number of bytes of each invocation's
+	// standard output and standard error included in progress messages.
+	sendCommandOutputMaxLength = 1000
+)
+
+// @Action(aws_ssm_send_command, name="Send Command")
+func newSendCommandAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &sendCommandAction{}, nil
+}
+
+var (
+	_ action.Action = (*sendCommandAction)(nil)
+)
+
+type sendCommandAction struct {
+	framework.ActionWithModel[sendCommandActionModel]
+}
+
+type sendCommandActionModel struct {
+	framework.WithRegionModel
+	Comment            types.String                                 `tfsdk:"comment"`
+	DocumentName       types.String                                 `tfsdk:"document_name"`
+	DocumentVersion    types.String                                 `tfsdk:"document_version"`
+	InstanceIDs        fwtypes.SetOfString                          `tfsdk:"instance_ids"`
+	MaxConcurrency     types.String                                 `tfsdk:"max_concurrency"`
+	MaxErrors          types.String                                 `tfsdk:"max_errors"`
+	OutputS3BucketName types.String                                 `tfsdk:"output_s3_bucket_name"`
+	OutputS3KeyPrefix  types.String                                 `tfsdk:"output_s3_key_prefix"`
+	Parameters         types.Map                                    `tfsdk:"parameters"`
+	Targets            fwtypes.ListNestedObjectValueOf[targetModel] `tfsdk:"targets"`
+	Timeout            types.Int64                                  `tfsdk:"timeout"`
+}
+
+type targetModel struct {
+	Key    types.String         `tfsdk:"key"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+func (a *sendCommandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an SSM document on managed instances using Run Command and waits for every invocation to finish.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrComment: schema.StringAttribute{
+				Description: "User-specified information about the command",
+				Optional:    true,
+			},
+			"document_name": schema.StringAttribute{
+				Description: "Name or ARN of the SSM document to run (e.g., AWS-RunShellScript)",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "Version of the SSM document to run",
+				Optional:    true,
+			},
+			"instance_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				Description: "IDs of the managed instances to run the command on",
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 50),
+					setvalidator.ExactlyOneOf(path.MatchRoot("targets")),
+				},
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "Maximum number or percentage of managed instances that can run the command at the same time",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "Number or percentage of failed invocations allowed before the command fails",
+				Optional:    true,
+			},
+			"output_s3_bucket_name": schema.StringAttribute{
+				Description: "Name of the S3 bucket where command output is stored",
+				Optional:    true,
+			},
+			"output_s3_key_prefix": schema.StringAttribute{
+				Description: "S3 key prefix for command output",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				Description: "Parameters to pass to the SSM document",
+				ElementType: types.ListType{ElemType: types.StringType},
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the command to finish (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(172800),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[targetModel](ctx),
+				Description: "Targets to run the command on, specified as key-value combinations (e.g., tag:Role = [web])",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Description: "Target key (e.g., InstanceIds, tag:Name, tag-key, resource-groups:Name)",
+							Required:    true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							Description: "Target values",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *sendCommandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sendCommandActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := fwflex.StringValueFromFramework(ctx, config.DocumentName)
+
+	// Set default timeout if not provided
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	input := ssm.SendCommandInput{
+		Comment:            fwflex.StringFromFramework(ctx, config.Comment),
+		DocumentName:       aws.String(documentName),
+		DocumentVersion:    fwflex.StringFromFramework(ctx, config.DocumentVersion),
+		InstanceIds:        fwflex.ExpandFrameworkStringValueSet(ctx, config.InstanceIDs),
+		MaxConcurrency:     fwflex.StringFromFramework(ctx, config.MaxConcurrency),
+		MaxErrors:          fwflex.StringFromFramework(ctx, config.MaxErrors),
+		OutputS3BucketName: fwflex.StringFromFramework(ctx, config.OutputS3BucketName),
+		OutputS3KeyPrefix:  fwflex.StringFromFramework(ctx, config.OutputS3KeyPrefix),
+	}
+
+	if !config.Parameters.IsNull() {
+		var parameters map[string][]string
+		resp.Diagnostics.Append(config.Parameters.ElementsAs(ctx, &parameters, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		input.Parameters = parameters
+	}
+
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.Targets, &input.Targets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Starting SSM send command action", map[string]any{
+		"document_name":   documentName,
+		"instance_ids":    input.InstanceIds,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Sending SSM command %s...", documentName)
+
+	output, err := conn.SendCommand(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Send Command",
+			fmt.Sprintf("Could not send SSM command %s: %s", documentName, err),
+		)
+		return
+	}
+
+	if output.Command == nil {
+		resp.Diagnostics.AddError(
+			"Failed to Send Command",
+			fmt.Sprintf("Sending SSM command %s returned no command", documentName),
+		)
+		return
+	}
+
+	commandID := aws.ToString(output.Command.CommandId)
+	cb(ctx, "SSM command %s sent, waiting for invocations to finish...", commandID)
+
+	// Wait for every invocation to finish with periodic progress updates using actionwait.
+	// The command status reflects max_errors: it is Failed once the error threshold is reached.
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Command], error) {
+		command, ferr := findCommandByID(ctx, conn, commandID)
+		if retry.NotFound(ferr) {
+			// Newly sent commands may not be immediately visible.
+			return actionwait.FetchResult[*awstypes.Command]{Status: actionwait.Status(awstypes.CommandStatusPending)}, nil
+		}
+		if ferr != nil {
+			return actionwait.FetchResult[*awstypes.Command]{}, fmt.Errorf("listing command: %w", ferr)
+		}
+		return actionwait.FetchResult[*awstypes.Command]{Status: actionwait.Status(command.Status), Value: command}, nil
+	}, actionwait.Options[*awstypes.Command]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(sendCommandPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.CommandStatusSuccess)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusPending),
+			actionwait.Status(awstypes.CommandStatusInProgress),
+			actionwait.Status(awstypes.CommandStatusCancelling),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusCancelled),
+			actionwait.Status(awstypes.CommandStatusFailed),
+			actionwait.Status(awstypes.CommandStatusTimedOut),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if command, ok := fr.Value.(*awstypes.Command); ok && command != nil {
+				cb(ctx, "SSM command %s is %s: %d of %d invocations completed, %d errors", commandID, fr.Status, command.CompletedCount, command.TargetCount, command.ErrorCount)
+				return
+			}
+			cb(ctx, "SSM command %s is currently in state '%s', continuing to wait for 'Success'...", commandID, fr.Status)
+		},
+	})
+
+	// Report per-instance results whether or not the command succeeded.
+	if !actionwait.IsTimeout(err) {
+		if rerr := reportCommandInvocations(ctx, conn, commandID, cb); rerr != nil {
+			resp.Diagnostics.AddWarning(
+				"Failed to Report Command Invocations",
+				fmt.Sprintf("Could not read invocations of SSM command %s: %s", commandID, rerr),
+			)
+		}
+	}
+
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Command",
+				fmt.Sprintf("SSM command %s did not finish within %s: %s", commandID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			detail := fmt.Sprintf("SSM command %s finished with status %s", commandID, failureErr.Status)
+			if command := fr.Value; command != nil {
+				detail += fmt.Sprintf(": %d of %d invocations failed (%d delivery timeouts), max errors %s", command.ErrorCount, command.TargetCount, command.DeliveryTimedOutCount, aws.ToString(command.MaxErrors))
+			}
+			resp.Diagnostics.AddError("Command Failed", detail)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Command State",
+				fmt.Sprintf("SSM command %s entered unexpected state: %s", commandID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Command",
+				fmt.Sprintf("Error while waiting for SSM command %s: %s", commandID, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	cb(ctx, "SSM command %s completed successfully", commandID)
+
+	tflog.Info(ctx, "SSM send command action completed successfully", map[string]any{
+		"command_id":    commandID,
+		"document_name": documentName,
+	})
+}
+
+// reportCommandInvocations sends a progress message for each instance the command ran on,
+// including the invocation status and truncated standard output and standard error of each plugin.
+// An instance whose plugin output can't be read is reported as such and the remaining instances are still reported.
+func reportCommandInvocations(ctx context.Context, conn *ssm.Client, commandID string, cb fwactions.SendProgressFunc) error {
+	input := ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(commandID),
+		Details:   true,
+	}
+	invocations, err := findCommandInvocations(ctx, conn, &input)
+	if err != nil {
+		return err
+	}
+
+	for _, invocation := range invocations {
+		instanceID := aws.ToString(invocation.InstanceId)
+		cb(ctx, "Instance %s: %s", instanceID, invocation.StatusDetails)
+
+		for _, plugin := range invocation.CommandPlugins {
+			input := ssm.GetCommandInvocationInput{
+				CommandId:  aws.String(commandID),
+				InstanceId: aws.String(instanceID),
+				PluginName: plugin.Name,
+			}
+			output, err := conn.GetCommandInvocation(ctx, &input)
+			if err != nil {
+				tflog.Warn(ctx, "Error getting SSM command invocation output", map[string]any{
+					"command_id":  commandID,
+					"instance_id": instanceID,
+					"error":       err.Error(),
+				})
+				cb(ctx, "Instance %s: unable to get command output: %s", instanceID, err)
+				break
+			}
+
+			if v := truncateCommandOutput(aws.ToString(output.StandardOutputContent)); v != "" {
+				cb(ctx, "Instance %s (%s) stdout:\n%s", instanceID, aws.ToString(plugin.Name), v)
+			}
+			if v := truncateCommandOutput(aws.ToString(output.StandardErrorContent)); v != "" {
+				cb(ctx, "Instance %s (%s) stderr:\n%s", instanceID, aws.ToString(plugin.Name), v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func truncateCommandOutput(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) <= sendCommandOutputMaxLength {
+		return s
+	}
+
+	// Back off to the start of a rune so that multi-byte characters aren't split.
+	n := sendCommandOutputMaxLength
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+
+	return s[:n] + "... (truncated)"
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	return findCommand(ctx, conn, &input)
+}
+
+func findCommand(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandsInput) (*awstypes.Command, error) {
+	output, err := findCommands(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCommands(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandsInput) ([]awstypes.Command, error) {
+	var output []awstypes.Command
+
+	pages := ssm.NewListCommandsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.InvalidCommandId](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Commands...)
+	}
+
+	return output, nil
+}
+
+func findCommandInvocations(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandInvocationsInput) ([]awstypes.CommandInvocation, error) {
+	var output []awstypes.CommandInvocation
+
+	pages := ssm.NewListCommandInvocationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CommandInvocations...)
+	}
+
+	return output, nil
+}
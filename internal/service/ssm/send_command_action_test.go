@@ -0,0 +1,228 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMSendCommandAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_instance.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccSendCommandActionRegistrationSleep(),
+				),
+			},
+			{
+				Config: testAccSendCommandActionConfig_targets(rName, "echo hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendCommandStatus(ctx, t, resourceName, rName, awstypes.CommandStatusSuccess),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMSendCommandAction_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccSendCommandActionRegistrationSleep(),
+				),
+			},
+			{
+				Config:      testAccSendCommandActionConfig_instanceIDs(rName, "exit 1"),
+				ExpectError: regexache.MustCompile(`Command Failed`),
+			},
+		},
+	})
+}
+
+func TestTruncateCommandOutput(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected string
+	}{
+		"short": {
+			input:    "  hello\n",
+			expected: "hello",
+		},
+		"ASCII": {
+			input:    strings.Repeat("a", 1001),
+			expected: strings.Repeat("a", 1000) + "... (truncated)",
+		},
+		"two-byte rune straddling limit": {
+			input:    strings.Repeat("a", 999) + "é" + "bc",
+			expected: strings.Repeat("a", 999) + "... (truncated)",
+		},
+		"three-byte rune straddling limit": {
+			input:    strings.Repeat("a", 998) + "€" + "bc",
+			expected: strings.Repeat("a", 998) + "... (truncated)",
+		},
+		"rune ending at limit": {
+			input:    strings.Repeat("a", 998) + "é" + "bc",
+			expected: strings.Repeat("a", 998) + "é" + "... (truncated)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfssm.TruncateCommandOutput(testCase.input)
+
+			if !utf8.ValidString(got) {
+				t.Errorf("TruncateCommandOutput() = %q, not valid UTF-8", got)
+			}
+			if got != testCase.expected {
+				t.Errorf("TruncateCommandOutput() = %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func testAccSendCommandActionRegistrationSleep() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		log.Print("[DEBUG] Test: Sleep to allow SSM Agent to register EC2 instance as a managed node.")
+		time.Sleep(1 * time.Minute)
+		return nil
+	}
+}
+
+func testAccCheckSendCommandStatus(ctx context.Context, t *testing.T, n, comment string, expected awstypes.CommandStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).SSMClient(ctx)
+
+		input := ssm.ListCommandsInput{
+			InstanceId: aws.String(rs.Primary.ID),
+		}
+		output, err := conn.ListCommands(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		for _, command := range output.Commands {
+			if aws.ToString(command.Comment) != comment {
+				continue
+			}
+
+			if command.Status != expected {
+				return fmt.Errorf("SSM Command (%s) status: expected %s, got %s", aws.ToString(command.CommandId), expected, command.Status)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("SSM Command (%s) not found for instance %s", comment, rs.Primary.ID)
+	}
+}
+
+func testAccSendCommandActionConfig_targets(rName, command string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_filterInstance(rName), fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    comment       = %[1]q
+    document_name = "AWS-RunShellScript"
+
+    parameters = {
+      commands = [%[2]q]
+    }
+
+    targets {
+      key    = "tag:Name"
+      values = [aws_instance.test.tags["Name"]]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`, rName, command))
+}
+
+func testAccSendCommandActionConfig_instanceIDs(rName, command string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_filterInstance(rName), fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    comment       = %[1]q
+    document_name = "AWS-RunShellScript"
+    instance_ids  = [aws_instance.test.id]
+    max_errors    = "0"
+    timeout       = 600
+
+    parameters = {
+      commands = [%[2]q]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`, rName, command))
+}
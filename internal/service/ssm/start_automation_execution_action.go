@@ -0,0 +1,338 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startAutomationExecutionPollInterval defines polling cadence for the start automation execution action.
+const startAutomationExecutionPollInterval = 10 * time.Second
+
+// @Action(aws_ssm_start_automation_execution, name="Start Automation Execution")
+func newStartAutomationExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startAutomationExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startAutomationExecutionAction)(nil)
+)
+
+type startAutomationExecutionAction struct {
+	framework.ActionWithModel[startAutomationExecutionActionModel]
+}
+
+type startAutomationExecutionActionModel struct {
+	framework.WithRegionModel
+	DocumentName        types.String                                 `tfsdk:"document_name"`
+	DocumentVersion     types.String                                 `tfsdk:"document_version"`
+	MaxConcurrency      types.String                                 `tfsdk:"max_concurrency"`
+	MaxErrors           types.String                                 `tfsdk:"max_errors"`
+	Parameters          types.Map                                    `tfsdk:"parameters"`
+	TargetParameterName types.String                                 `tfsdk:"target_parameter_name"`
+	Targets             fwtypes.ListNestedObjectValueOf[targetModel] `tfsdk:"targets"`
+	Timeout             types.Int64                                  `tfsdk:"timeout"`
+}
+
+func (a *startAutomationExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an SSM Automation runbook execution and waits for it to reach a terminal status.",
+		Attributes: map[string]schema.Attribute{
+			"document_name": schema.StringAttribute{
+				Description: "Name or ARN of the Automation runbook to run (e.g., AWS-RestartEC2Instance)",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "Version of the Automation runbook to run",
+				Optional:    true,
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "Maximum number or percentage of targets that can run the runbook at the same time",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "Number or percentage of errors allowed before the execution stops running on additional targets",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				Description: "Parameters to pass to the Automation runbook",
+				ElementType: types.ListType{ElemType: types.StringType},
+				Optional:    true,
+			},
+			"target_parameter_name": schema.StringAttribute{
+				Description: "Name of the runbook parameter that receives the target values when using targets",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the execution to finish (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(172800),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[targetModel](ctx),
+				Description: "Targets to run the runbook on using rate control, specified as key-value combinations (e.g., tag:Role = [web])",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Description: "Target key (e.g., ParameterValues, tag:Name, ResourceGroup)",
+							Required:    true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							Description: "Target values",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *startAutomationExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startAutomationExecutionActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := fwflex.StringValueFromFramework(ctx, config.DocumentName)
+
+	// Set default timeout if not provided
+	timeout := fwactions.TimeoutOr(config.Timeout, 60*time.Minute)
+
+	input := ssm.StartAutomationExecutionInput{
+		DocumentName:        aws.String(documentName),
+		DocumentVersion:     fwflex.StringFromFramework(ctx, config.DocumentVersion),
+		MaxConcurrency:      fwflex.StringFromFramework(ctx, config.MaxConcurrency),
+		MaxErrors:           fwflex.StringFromFramework(ctx, config.MaxErrors),
+		TargetParameterName: fwflex.StringFromFramework(ctx, config.TargetParameterName),
+	}
+
+	if !config.Parameters.IsNull() {
+		var parameters map[string][]string
+		resp.Diagnostics.Append(config.Parameters.ElementsAs(ctx, &parameters, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		input.Parameters = parameters
+	}
+
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.Targets, &input.Targets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Starting SSM start automation execution action", map[string]any{
+		"document_name":   documentName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting SSM Automation execution of %s...", documentName)
+
+	output, err := conn.StartAutomationExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Automation Execution",
+			fmt.Sprintf("Could not start SSM Automation execution of %s: %s", documentName, err),
+		)
+		return
+	}
+
+	executionID := aws.ToString(output.AutomationExecutionId)
+	cb(ctx, "SSM Automation execution %s started, waiting for completion...", executionID)
+
+	// Report each step as soon as its status changes, independently of the throttled progress sink.
+	stepStatuses := make(map[string]awstypes.AutomationExecutionStatus)
+	reportSteps := func(execution *awstypes.AutomationExecution) {
+		for _, step := range execution.StepExecutions {
+			id := aws.ToString(step.StepExecutionId)
+			if status, ok := stepStatuses[id]; ok && status == step.StepStatus {
+				continue
+			}
+			stepStatuses[id] = step.StepStatus
+
+			if message := aws.ToString(step.FailureMessage); message != "" {
+				cb(ctx, "Step %s (%s): %s: %s", aws.ToString(step.StepName), aws.ToString(step.Action), step.StepStatus, message)
+			} else {
+				cb(ctx, "Step %s (%s): %s", aws.ToString(step.StepName), aws.ToString(step.Action), step.StepStatus)
+			}
+		}
+	}
+
+	// Wait for the execution to reach a terminal status using actionwait
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.AutomationExecution], error) {
+		execution, ferr := findAutomationExecutionByID(ctx, conn, executionID)
+		if ferr != nil {
+			return actionwait.FetchResult[*awstypes.AutomationExecution]{}, fmt.Errorf("getting automation execution: %w", ferr)
+		}
+		reportSteps(execution)
+		return actionwait.FetchResult[*awstypes.AutomationExecution]{Status: actionwait.Status(execution.AutomationExecutionStatus), Value: execution}, nil
+	}, actionwait.Options[*awstypes.AutomationExecution]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startAutomationExecutionPollInterval),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusSuccess),
+			actionwait.Status(awstypes.AutomationExecutionStatusCompletedWithSuccess),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusPending),
+			actionwait.Status(awstypes.AutomationExecutionStatusInProgress),
+			actionwait.Status(awstypes.AutomationExecutionStatusWaiting),
+			actionwait.Status(awstypes.AutomationExecutionStatusCancelling),
+			actionwait.Status(awstypes.AutomationExecutionStatusPendingApproval),
+			actionwait.Status(awstypes.AutomationExecutionStatusApproved),
+			actionwait.Status(awstypes.AutomationExecutionStatusScheduled),
+			actionwait.Status(awstypes.AutomationExecutionStatusRunbookInProgress),
+			actionwait.Status(awstypes.AutomationExecutionStatusPendingChangeCalendarOverride),
+			actionwait.Status(awstypes.AutomationExecutionStatusChangeCalendarOverrideApproved),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusCancelled),
+			actionwait.Status(awstypes.AutomationExecutionStatusChangeCalendarOverrideRejected),
+			actionwait.Status(awstypes.AutomationExecutionStatusCompletedWithFailure),
+			actionwait.Status(awstypes.AutomationExecutionStatusExited),
+			actionwait.Status(awstypes.AutomationExecutionStatusFailed),
+			actionwait.Status(awstypes.AutomationExecutionStatusRejected),
+			actionwait.Status(awstypes.AutomationExecutionStatusTimedOut),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "SSM Automation execution %s is currently in state '%s', continuing to wait for 'Success'...", executionID, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Automation Execution",
+				fmt.Sprintf("SSM Automation execution %s did not finish within %s: %s", executionID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Automation Execution Failed",
+				automationExecutionFailureDetail(fr.Value, executionID, failureErr.Status),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Automation Execution State",
+				fmt.Sprintf("SSM Automation execution %s entered unexpected state: %s", executionID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Automation Execution",
+				fmt.Sprintf("Error while waiting for SSM Automation execution %s: %s", executionID, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	cb(ctx, "SSM Automation execution %s completed successfully", executionID)
+
+	tflog.Info(ctx, "SSM start automation execution action completed successfully", map[string]any{
+		"automation_execution_id": executionID,
+		"document_name":           documentName,
+	})
+}
+
+// automationExecutionFailureDetail describes a failed automation execution, including the
+// failure message and failure details of each step that did not succeed.
+func automationExecutionFailureDetail(execution *awstypes.AutomationExecution, executionID string, status actionwait.Status) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "SSM Automation execution %s finished with status %s", executionID, status)
+	if execution == nil {
+		return sb.String()
+	}
+
+	if message := aws.ToString(execution.FailureMessage); message != "" {
+		fmt.Fprintf(&sb, ": %s", message)
+	}
+
+	for _, step := range execution.StepExecutions {
+		switch step.StepStatus {
+		case awstypes.AutomationExecutionStatusCancelled,
+			awstypes.AutomationExecutionStatusFailed,
+			awstypes.AutomationExecutionStatusTimedOut:
+		default:
+			continue
+		}
+
+		fmt.Fprintf(&sb, "\n\nStep %s (%s): %s", aws.ToString(step.StepName), aws.ToString(step.Action), step.StepStatus)
+		if message := aws.ToString(step.FailureMessage); message != "" {
+			fmt.Fprintf(&sb, ": %s", message)
+		}
+		if v := step.FailureDetails; v != nil {
+			fmt.Fprintf(&sb, " (failure stage: %s, failure type: %s)", aws.ToString(v.FailureStage), aws.ToString(v.FailureType))
+		}
+	}
+
+	return sb.String()
+}
+
+func findAutomationExecutionByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.AutomationExecution, error) {
+	input := ssm.GetAutomationExecutionInput{
+		AutomationExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetAutomationExecution(ctx, &input)
+
+	if errs.IsA[*awstypes.AutomationExecutionNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AutomationExecution == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.AutomationExecution, nil
+}
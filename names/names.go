@@ -168,6 +168,104 @@ func PartitionForRegion(region string) endpoints.Partition {
 	return PartitionForRegion(endpoints.UsEast1RegionID)
 }
 
+// DualStackDNSSuffixForPartition returns the DNS suffix of dual-stack endpoints in the given partition.
+// Returns the empty string if the partition is not known.
+//
+// The partition metadata in aws-sdk-go-base does not include the dual-stack DNS suffix, so it is taken from
+// the dualStackDnsSuffix values in the AWS SDK's partitions.json.
+func DualStackDNSSuffixForPartition(partition endpoints.Partition) string {
+	switch partition.ID() {
+	case endpoints.AwsPartitionID, endpoints.AwsUsGovPartitionID:
+		return "api.aws"
+	case endpoints.AwsCnPartitionID:
+		return "api.amazonwebservices.com.cn"
+	case endpoints.AwsEuscPartitionID:
+		return "api.amazonwebservices.eu"
+	case endpoints.AwsIsoPartitionID:
+		return "api.aws.ic.gov"
+	case endpoints.AwsIsoBPartitionID:
+		return "api.aws.scloud"
+	case endpoints.AwsIsoEPartitionID:
+		return "api.cloud-aws.adc-e.uk"
+	case endpoints.AwsIsoFPartitionID:
+		return "api.aws.hci.ic.gov"
+	}
+
+	return ""
+}
+
+// RegionIsOptIn returns whether the given Region must be enabled in an account before it can be used.
+//
+// The partition metadata does not include opt-in status. Every Region in the standard partition
+// launched after 20 March 2019 is opt-in, so the opt-in Regions are those of the standard partition
+// not in the fixed set of Regions enabled by default. Regions in other partitions are never opt-in.
+func RegionIsOptIn(region string) bool {
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	if !ok || partition.ID() != endpoints.AwsPartitionID {
+		return false
+	}
+
+	switch region {
+	case endpoints.ApNortheast1RegionID,
+		endpoints.ApNortheast2RegionID,
+		endpoints.ApNortheast3RegionID,
+		endpoints.ApSouth1RegionID,
+		endpoints.ApSoutheast1RegionID,
+		endpoints.ApSoutheast2RegionID,
+		endpoints.CaCentral1RegionID,
+		endpoints.EuCentral1RegionID,
+		endpoints.EuNorth1RegionID,
+		endpoints.EuWest1RegionID,
+		endpoints.EuWest2RegionID,
+		endpoints.EuWest3RegionID,
+		endpoints.SaEast1RegionID,
+		endpoints.UsEast1RegionID,
+		endpoints.UsEast2RegionID,
+		endpoints.UsWest1RegionID,
+		endpoints.UsWest2RegionID:
+		return false
+	}
+
+	return true
+}
+
+// ServicePrincipalSuffixForPartition returns the suffix of the service principal for the given service in the given partition.
+// Returns the suffix used by most services if the service is empty.
+//
+// SPN region unique taken from
+// https://github.com/aws/aws-cdk/blob/main/packages/aws-cdk-lib/region-info/lib/default.ts
+func ServicePrincipalSuffixForPartition(service string, partition endpoints.Partition) string {
+	if partitionID := partition.ID(); service != "" && partitionID != endpoints.AwsPartitionID {
+		switch partitionID {
+		case endpoints.AwsIsoPartitionID:
+			switch service {
+			case "cloudhsm",
+				"config",
+				"logs",
+				"workspaces":
+				return partition.DNSSuffix()
+			}
+		case endpoints.AwsIsoBPartitionID:
+			switch service {
+			case "dms",
+				"logs":
+				return partition.DNSSuffix()
+			}
+		case endpoints.AwsCnPartitionID:
+			switch service {
+			case "codedeploy",
+				"elasticmapreduce",
+				"logs",
+				"ec2",
+				"s3":
+				return partition.DNSSuffix()
+			}
+		}
+	}
+
+	return "amazonaws.com"
+}
+
 // Type ServiceDatum corresponds closely to attributes and blocks in `data/names_data.hcl` and are
 // described in detail in README.md.
 type serviceDatum struct {
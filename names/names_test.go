@@ -60,6 +60,162 @@ func TestPartitionForRegion(t *testing.T) {
 	}
 }
 
+func TestDualStackDNSSuffixForPartition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		region   string
+		expected string
+	}{
+		{
+			name:     "empty",
+			region:   "",
+			expected: "",
+		},
+		{
+			name:     "China",
+			region:   endpoints.CnNorth1RegionID,
+			expected: "api.amazonwebservices.com.cn",
+		},
+		{
+			name:     "GovCloud",
+			region:   endpoints.UsGovWest1RegionID,
+			expected: "api.aws",
+		},
+		{
+			name:     "ISO",
+			region:   endpoints.UsIsoEast1RegionID,
+			expected: "api.aws.ic.gov",
+		},
+		{
+			name:     "standard",
+			region:   endpoints.UsWest2RegionID,
+			expected: "api.aws",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := DualStackDNSSuffixForPartition(PartitionForRegion(testCase.region)), testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
+
+func TestDualStackDNSSuffixForPartition_allPartitions(t *testing.T) {
+	t.Parallel()
+
+	for _, partition := range endpoints.DefaultPartitions() {
+		t.Run(partition.ID(), func(t *testing.T) {
+			t.Parallel()
+
+			if got := DualStackDNSSuffixForPartition(partition); got == "" {
+				t.Errorf("no dual-stack DNS suffix for partition %s", partition.ID())
+			}
+		})
+	}
+}
+
+func TestRegionIsOptIn(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		region   string
+		expected bool
+	}{
+		{
+			name:     "empty",
+			region:   "",
+			expected: false,
+		},
+		{
+			name:     "enabled by default",
+			region:   endpoints.UsWest2RegionID,
+			expected: false,
+		},
+		{
+			name:     "opt-in",
+			region:   endpoints.AfSouth1RegionID,
+			expected: true,
+		},
+		{
+			name:     "China",
+			region:   endpoints.CnNorth1RegionID,
+			expected: false,
+		},
+		{
+			name:     "GovCloud",
+			region:   endpoints.UsGovWest1RegionID,
+			expected: false,
+		},
+		{
+			name:     "unknown",
+			region:   "custom",
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := RegionIsOptIn(testCase.region), testCase.expected; got != want {
+				t.Errorf("got: %t, expected: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestServicePrincipalSuffixForPartition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		service  string
+		region   string
+		expected string
+	}{
+		{
+			name:     "empty service",
+			region:   endpoints.CnNorth1RegionID,
+			expected: "amazonaws.com",
+		},
+		{
+			name:     "standard",
+			service:  "logs",
+			region:   endpoints.UsWest2RegionID,
+			expected: "amazonaws.com",
+		},
+		{
+			name:     "China",
+			service:  "logs",
+			region:   endpoints.CnNorth1RegionID,
+			expected: "amazonaws.com.cn",
+		},
+		{
+			name:     "China default",
+			service:  "lambda",
+			region:   endpoints.CnNorth1RegionID,
+			expected: "amazonaws.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ServicePrincipalSuffixForPartition(testCase.service, PartitionForRegion(testCase.region)), testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
+
 func TestProviderPackageForAlias(t *testing.T) {
 	t.Parallel()
 